@@ -2,6 +2,8 @@ package main
 
 import (
 	"log"
+	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
@@ -12,13 +14,19 @@ import (
 	"github.com/GunarsK-portfolio/portfolio-common/metrics"
 	"github.com/GunarsK-portfolio/portfolio-common/server"
 	_ "github.com/GunarsK-portfolio/public-api/docs"
+	"github.com/GunarsK-portfolio/public-api/internal/cache"
 	"github.com/GunarsK-portfolio/public-api/internal/config"
 	"github.com/GunarsK-portfolio/public-api/internal/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
 	"github.com/GunarsK-portfolio/public-api/internal/repository"
 	"github.com/GunarsK-portfolio/public-api/internal/routes"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 )
 
+// imageInfoCacheEntries bounds the in-process image details cache used without Redis
+const imageInfoCacheEntries = 10000
+
 // @title Portfolio Public API
 // @version 1.0
 // @description Public read-only API for portfolio data
@@ -39,10 +47,11 @@ func main() {
 	appLogger.Info("Starting public API", "version", "1.0")
 
 	// Initialize Prometheus metrics
-	metricsCollector := metrics.New(metrics.Config{
+	metricsConfig := metrics.Config{
 		ServiceName: "public",
 		Namespace:   "portfolio",
-	})
+	}
+	metricsCollector := metrics.New(metricsConfig)
 
 	// Connect to database
 	//nolint:staticcheck // Embedded field name required due to ambiguous fields
@@ -69,11 +78,75 @@ func main() {
 	healthAgg := health.NewAggregator(3 * time.Second)
 	healthAgg.Register(health.NewPostgresChecker(db))
 
-	// Initialize repository
-	repo := repository.New(db, cfg.FilesAPIURL)
+	var redisClient *redis.Client
+	if cfg.Cache.RedisEnabled {
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     net.JoinHostPort(cfg.Cache.Redis.Host, strconv.Itoa(cfg.Cache.Redis.Port)),
+			Password: cfg.Cache.Redis.Password,
+		})
+		defer func() {
+			if closeErr := redisClient.Close(); closeErr != nil {
+				appLogger.Error("Failed to close Redis client", "error", closeErr)
+			}
+		}()
+		healthAgg.Register(health.NewRedisChecker(redisClient))
+	}
+
+	// Image details are computed once per stored file and shared through Redis when available
+	var imageInfoStore imaging.Store = cache.NewMemoryStore(imageInfoCacheEntries, nil)
+	if redisClient != nil {
+		imageInfoStore = cache.NewRedisStore(redisClient)
+	}
+	repoOpts := []repository.Option{
+		repository.WithImageInspector(imaging.NewInspector(&http.Client{}, imageInfoStore, appLogger)),
+	}
+
+	// Initialize repository. Image URLs point at this API's image endpoint,
+	// which strips metadata, so it needs the API's public URL.
+	repo := repository.New(db, cfg.FilesAPIURL, cfg.PublicAPIURL, repoOpts...)
+
+	// Optional Redis response cache in front of the repository
+	var redisStore cache.Store
+	if redisClient != nil {
+		redisStore = cache.NewRedisStore(redisClient)
+		repo = cache.NewRepository(repo, redisStore, cfg.Cache.TTLs, cache.Options{
+			Name:    cache.RedisCacheName,
+			Metrics: metricsCollector,
+			Logger:  appLogger,
+		})
+		appLogger.Info("Redis repository cache enabled", "host", cfg.Cache.Redis.Host)
+	}
+
+	// Optional in-process LRU cache, outermost so hot reads skip fetching values
+	// from Redis. Its key version still comes from Redis, so a version bump
+	// there invalidates every instance at once.
+	if cfg.Cache.MemoryEnabled {
+		memoryStore := cache.NewMemoryStore(cfg.Cache.MemoryMaxEntries, metricsCollector)
+		repo = cache.NewRepository(repo, memoryStore, cfg.Cache.TTLs.Capped(cfg.Cache.MemoryTTL), cache.Options{
+			Name:         cache.MemoryCacheName,
+			Metrics:      metricsCollector,
+			Logger:       appLogger,
+			VersionStore: redisStore,
+		})
+		appLogger.Info("In-process repository cache enabled", "maxEntries", cfg.Cache.MemoryMaxEntries)
+	}
 
 	// Initialize handlers
-	handler := handlers.New(repo)
+	handlerOpts := []handlers.Option{
+		handlers.WithSiteURL(cfg.PublicSiteURL),
+		handlers.WithAPIURL(cfg.PublicAPIURL),
+		handlers.WithImageSigningKey(cfg.Images.SigningKey),
+	}
+	if cfg.Images.CacheDir != "" {
+		imageCache, err := cache.NewDiskStore(cfg.Images.CacheDir, int64(cfg.Images.CacheMaxMB)<<20)
+		if err != nil {
+			appLogger.Error("Failed to open image cache", "error", err)
+			log.Fatal("Failed to open image cache:", err)
+		}
+		handlerOpts = append(handlerOpts, handlers.WithImageCache(imageCache))
+		appLogger.Info("Disk image cache enabled", "dir", cfg.Images.CacheDir, "maxMB", cfg.Images.CacheMaxMB)
+	}
+	handler := handlers.New(repo, handlerOpts...)
 
 	// Setup router with custom middleware
 	router := gin.New()
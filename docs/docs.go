@@ -79,23 +79,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/miniatures": {
+        "/feed.atom": {
             "get": {
-                "description": "Get list of all miniature painting projects with images",
+                "description": "Get the newest projects and miniatures as an Atom 1.0 feed",
                 "produces": [
-                    "application/json"
+                    "application/atom+xml"
                 ],
                 "tags": [
-                    "miniatures"
+                    "feeds"
+                ],
+                "summary": "Atom feed of recent content",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all miniature projects",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Atom document",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -111,30 +134,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/miniatures/projects/{id}": {
+        "/feed.json": {
             "get": {
-                "description": "Get detailed information about a specific miniature project",
+                "description": "Get the newest projects and miniatures as a JSON Feed 1.1 document",
                 "produces": [
-                    "application/json"
+                    "application/feed+json"
                 ],
                 "tags": [
-                    "miniatures"
+                    "feeds"
                 ],
-                "summary": "Get miniature project by ID",
+                "summary": "JSON Feed of recent content",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Maximum number of entries (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "JSON Feed document",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -167,23 +189,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/miniatures/themes": {
+        "/feed.rss": {
             "get": {
-                "description": "Get list of all miniature themes with cover images",
+                "description": "Get the newest projects and miniatures as an RSS 2.0 feed",
                 "produces": [
-                    "application/json"
+                    "application/rss+xml"
                 ],
                 "tags": [
-                    "miniatures"
+                    "feeds"
+                ],
+                "summary": "RSS feed of recent content",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all miniature themes",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "RSS document",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -199,32 +244,74 @@ const docTemplate = `{
                 }
             }
         },
-        "/miniatures/themes/{id}": {
+        "/img/{fileId}": {
             "get": {
-                "description": "Get detailed information about a specific miniature theme with its projects",
+                "description": "Get a rendition of a published image, upright and without EXIF or XMP metadata. Unsigned\nrequests may only ask for the full size (no w or h) or one of the variant widths (320, 768\nor 1600) listed in the variants of every image in other responses. Any other combination of\nw, h and fit needs a signature s (see README). Images are never enlarged. Without fmt, JPEG is\nserved, except that PNG, GIF and WebP originals, which may be transparent, are served as\nlossless WebP to clients that accept it. The ETag identifies the rendition, so revalidation\nnever renders the image.",
                 "produces": [
-                    "application/json"
+                    "image/jpeg",
+                    "image/webp"
                 ],
                 "tags": [
-                    "miniatures"
+                    "images"
                 ],
-                "summary": "Get miniature theme by ID",
+                "summary": "Get a resized image",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Theme ID",
-                        "name": "id",
+                        "description": "Storage file ID",
+                        "name": "fileId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum width, or box width with fit=cover (1-4096); full size without w and h",
+                        "name": "w",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum height, or box height with fit=cover (1-4096)",
+                        "name": "h",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "inside",
+                            "cover"
+                        ],
+                        "type": "string",
+                        "description": "inside (default) or cover, which crops to exactly w×h",
+                        "name": "fit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "jpeg",
+                            "webp"
+                        ],
+                        "type": "string",
+                        "description": "Output format; negotiated from Accept when omitted",
+                        "name": "fmt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the fileId, w, h and fit parameters",
+                        "name": "s",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme"
+                            "type": "file"
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -234,6 +321,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -251,25 +347,68 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/profile": {
+        "/meta/miniatures/{id}": {
             "get": {
-                "description": "Get the portfolio owner's profile information",
+                "description": "Get the title, description, canonical URL and image used for social previews of a miniature page.\nWith format=html an HTML document with og:* and twitter:* tags is returned for crawlers.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/html"
                 ],
                 "tags": [
-                    "profile"
+                    "meta"
+                ],
+                "summary": "Get miniature preview metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format (json, html)",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get profile information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Profile"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PageMeta"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
@@ -293,23 +432,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects": {
+        "/meta/projects/{id}": {
             "get": {
-                "description": "Get list of all portfolio projects with technologies",
+                "description": "Get the title, description, canonical URL and image used for social previews of a project page.\nThe image is the generated /og/projects/{id}.png card.\nWith format=html an HTML document with og:* and twitter:* tags is returned for crawlers.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/html"
                 ],
                 "tags": [
-                    "projects"
+                    "meta"
+                ],
+                "summary": "Get project preview metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format (json, html)",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all portfolio projects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PageMeta"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -325,30 +495,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{id}": {
+        "/meta/themes/{id}": {
             "get": {
-                "description": "Get detailed information about a specific portfolio project",
+                "description": "Get the title, description, canonical URL and image used for social previews of a theme page.\nThe cover image is used, falling back to the first miniature image in the theme.\nWith format=html an HTML document with og:* and twitter:* tags is returned for crawlers.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/html"
                 ],
                 "tags": [
-                    "projects"
+                    "meta"
                 ],
-                "summary": "Get portfolio project by ID",
+                "summary": "Get miniature theme preview metadata",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Project ID",
+                        "description": "Theme ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format (json, html)",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PageMeta"
                         }
                     },
                     "400": {
@@ -381,23 +558,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/skills": {
+        "/miniatures": {
             "get": {
-                "description": "Get list of all visible skills organized by category",
+                "description": "Get list of miniature painting projects with images.\nReturns a plain array unless page or limit is given, in which case a\nmodels.PaginatedResponse envelope with data and pagination is returned instead.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "skills"
+                    "miniatures"
+                ],
+                "summary": "Get all miniature projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (1-based)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by theme ID",
+                        "name": "themeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by scale",
+                        "name": "scale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by manufacturer",
+                        "name": "manufacturer",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by difficulty",
+                        "name": "difficulty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by technique ID",
+                        "name": "techniqueId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by paint ID",
+                        "name": "paintId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (title, scale, difficulty, displayOrder, completedDate, createdAt, updatedAt)",
+                        "name": "sort",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all skills",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Skill"
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -412,42 +654,2259 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_GunarsK-portfolio_portfolio-common_models.Image": {
-            "type": "object",
-            "properties": {
-                "caption": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "url": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.MiniaturePaint": {
-            "type": "object",
-            "required": [
-                "manufacturer",
-                "name"
-            ],
-            "properties": {
-                "colorHex": {
-                    "description": "ColorHex is the hexadecimal color code in #RRGGBB or #RGB format (e.g., #FF5733, #F00)",
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "manufacturer": {
-                    "type": "string"
+        "/miniatures/paints": {
+            "get": {
+                "description": "Get the paint catalogue ordered by manufacturer and name, with the number of miniatures using each paint",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get all paints",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PaintWithUsage"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/paints/similar": {
+            "get": {
+                "description": "Rank catalogue paints of every manufacturer by CIEDE2000 colour difference (ΔE2000) from hex.\nPaints without a valid colour are skipped. Below ΔE 2 two colours are hard to tell apart;\nabove 10 they read as clearly different. With miniatures=true the miniatures painted with\nany of the returned paints are included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Find paints with a similar colour",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Colour as #RRGGBB or #RGB; the # is optional and must be URL-encoded as %23",
+                        "name": "hex",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of paints (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return paints within this ΔE2000 distance",
+                        "name": "maxDeltaE",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include miniatures that use any of the returned paints",
+                        "name": "miniatures",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.SimilarPaints"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/paints/{id}": {
+            "get": {
+                "description": "Get a paint with its usage count and every miniature project it was used on",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniatures painted with a paint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Paint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PaintMiniatures"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/projects/by-slug/{slug}": {
+            "get": {
+                "description": "Get detailed information about a miniature project by its canonical slug, derived from the\ntitle. Older forms of the slug (a bare ID, \"\u003cid\u003e-\u003ctitle\u003e\" or a duplicate suffix no longer\nneeded) are answered with a 301 redirect to the canonical slug.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniature project by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Miniature project slug, e.g. space-marine",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureDetail"
+                        }
+                    },
+                    "301": {
+                        "description": "Redirect to the canonical slug"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/projects/{id}": {
+            "get": {
+                "description": "Get detailed information about a specific miniature project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniature project by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/projects/{id}/archive.zip": {
+            "get": {
+                "description": "Stream a ZIP of the photos of a miniature in display order, followed by manifest.json\ndescribing the miniature with its paints, techniques and photos. Photos are full-size JPEGs\nwithout EXIF metadata. A photo that cannot be fetched is left out and listed in the manifest\nwithout a file.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Download a miniature gallery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/projects/{id}/navigation": {
+            "get": {
+                "description": "Get the previous and next miniatures in list order and the miniatures sharing the most\ntheme, techniques and paints. Pass the same sort and filter parameters as GET /miniatures\nto navigate within that list.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniature project navigation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum related miniatures (default 4, max 12, 0 to skip)",
+                        "name": "related",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort fields as for GET /miniatures",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by theme ID",
+                        "name": "themeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by scale",
+                        "name": "scale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by manufacturer",
+                        "name": "manufacturer",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by difficulty",
+                        "name": "difficulty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by technique ID",
+                        "name": "techniqueId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by paint ID",
+                        "name": "paintId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Navigation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/techniques": {
+            "get": {
+                "description": "Get the painting technique catalogue in display order, with the number of miniatures using each technique",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get all techniques",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.TechniqueWithUsage"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/techniques/{id}": {
+            "get": {
+                "description": "Get a painting technique with its usage count and every miniature project it was used on",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniatures using a technique",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Technique ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.TechniqueMiniatures"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes": {
+            "get": {
+                "description": "Get list of all miniature themes with cover images",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get all miniature themes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/by-slug/{slug}": {
+            "get": {
+                "description": "Get detailed information about a miniature theme and its projects by the theme's canonical\nslug, derived from its name. Older forms of the slug (a bare ID, \"\u003cid\u003e-\u003cname\u003e\" or a duplicate\nsuffix no longer needed) are answered with a 301 redirect to the canonical slug.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniature theme by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Miniature theme slug, e.g. warhammer-40k",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.ThemeDetail"
+                        }
+                    },
+                    "301": {
+                        "description": "Redirect to the canonical slug"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}": {
+            "get": {
+                "description": "Get detailed information about a specific miniature theme with its projects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Get miniature theme by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.ThemeDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}/archive.zip": {
+            "get": {
+                "description": "Stream a ZIP of the photos of every miniature in a theme, one folder per miniature in display\norder, followed by manifest.json describing each miniature with its paints, techniques and\nphotos. Photos are full-size JPEGs without EXIF metadata. A photo that cannot be fetched is\nleft out and listed in the manifest without a file.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "miniatures"
+                ],
+                "summary": "Download a miniature theme gallery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/og/projects/{id}.png": {
+            "get": {
+                "description": "Get a 1200×630 PNG Open Graph image with the project title, technology badges and project image.\nProjects without an image get a text-only card.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "Get project preview image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio": {
+            "get": {
+                "description": "Get profile, experience, certifications, skills and projects in one document.\nSections are loaded concurrently; a section that fails is left empty and\nreported in the errors map instead of failing the whole response.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Get portfolio bundle",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PortfolioBundle"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/profile": {
+            "get": {
+                "description": "Get the portfolio owner's profile information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Get profile information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Profile"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/profile.vcf": {
+            "get": {
+                "description": "Get the portfolio owner's contact details as a vCard 4.0 (RFC 6350)",
+                "produces": [
+                    "text/vcard"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Get profile as vCard",
+                "responses": {
+                    "200": {
+                        "description": "vCard document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/profile/qr.png": {
+            "get": {
+                "description": "Get a PNG QR code encoding a compact profile vCard (default) or the public site URL.\nThe url option requires PUBLIC_SITE_URL to be configured.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Get profile QR code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "QR content: vcard (default) or url",
+                        "name": "content",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Image size in pixels (128-1024, default 512)",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "description": "Get a page of portfolio projects with technologies, wrapped in an envelope with data and\npagination. Without page or limit the first 20 projects are returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get all portfolio projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (1-based)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending (title, category, featured, displayOrder, startDate, endDate, createdAt, updatedAt)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by featured flag",
+                        "name": "featured",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by ongoing flag",
+                        "name": "isOngoing",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by technology (skill ID or name)",
+                        "name": "technology",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PaginatedResponse-github_com_GunarsK-portfolio_public-api_internal_models_PortfolioProject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/by-slug/{slug}": {
+            "get": {
+                "description": "Get detailed information about a portfolio project by its canonical slug, derived from the title.\nOlder forms of the slug (a bare ID, \"\u003cid\u003e-\u003ctitle\u003e\" or a duplicate suffix no longer needed) are\nanswered with a 301 redirect to the canonical slug.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get portfolio project by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project slug, e.g. portfolio-website",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.ProjectDetail"
+                        }
+                    },
+                    "301": {
+                        "description": "Redirect to the canonical slug"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}": {
+            "get": {
+                "description": "Get detailed information about a specific portfolio project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get portfolio project by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.ProjectDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/navigation": {
+            "get": {
+                "description": "Get the previous and next projects in list order and the projects sharing the most technologies.\nPass the same sort and filter parameters as GET /projects to navigate within that list.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project navigation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum related projects (default 4, max 12, 0 to skip)",
+                        "name": "related",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort fields as for GET /projects",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by featured flag",
+                        "name": "featured",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by ongoing flag",
+                        "name": "isOngoing",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by technology (skill ID or name)",
+                        "name": "technology",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Navigation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/resume.json": {
+            "get": {
+                "description": "Get profile, work experience, certifications, skills and projects mapped to the\nJSON Resume v1.0.0 schema (https://jsonresume.org/schema) for use with résumé themes and job boards",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume"
+                ],
+                "summary": "Get résumé in JSON Resume format",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Resume"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/resume.pdf": {
+            "get": {
+                "description": "Get a PDF résumé rendered from the current profile, work experience, certifications,\nskills and projects. The rendered file is reused until the underlying data changes.\nWith source=upload, or if rendering fails, redirects to the manually uploaded résumé file.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "resume"
+                ],
+                "summary": "Get résumé as PDF",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set to \\",
+                        "name": "source",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "302": {
+                        "description": "Redirect to the uploaded résumé file"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Search projects, work experience, skills and miniatures.\nq uses web search syntax: quoted phrases, OR, and -term to exclude.\nResults are ranked by relevance; snippets are HTML-escaped with matches wrapped in \u003cmark\u003e tags.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Full-text search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated result types: project, experience, skill, miniature",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of results (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.SearchResult"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sitemap.xml": {
+            "get": {
+                "description": "Get a sitemaps.org sitemap of every project, miniature and theme page on the public website.\nWhen there are more URLs than one sitemap may hold, a sitemap index is returned instead\nand each sitemap is served with the page parameter. Served at the root (/sitemap.xml),\nnot under the API base path.",
+                "produces": [
+                    "text/xml"
+                ],
+                "tags": [
+                    "seo"
+                ],
+                "summary": "Sitemap of public website pages",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sitemap page listed in the sitemap index",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sitemap or sitemap index document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/skills": {
+            "get": {
+                "description": "Get list of all visible skills organized by category.\nWith group=type, returns skill types in display order, each holding its\nordered skills and the number of projects using every skill\n(an array of models.SkillGroup) instead of the flat list.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Get all skills",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group skills; only \\",
+                        "name": "group",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Skill"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/skills/{id}/projects": {
+            "get": {
+                "description": "Get a visible skill together with every portfolio project that lists it as a technology",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Get projects using a skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.SkillProjects"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_GunarsK-portfolio_portfolio-common_models.Image": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.MiniaturePaint": {
+            "type": "object",
+            "required": [
+                "manufacturer",
+                "name"
+            ],
+            "properties": {
+                "colorHex": {
+                    "description": "ColorHex is the hexadecimal color code in #RRGGBB or #RGB format (e.g., #FF5733, #F00)",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paintType": {
+                    "description": "PaintType categorizes the paint (Base, Layer, Shade, Wash, Contrast, Dry, Technical, Metallic, Air, Primer, Edge, Glaze, Ink)\nDatabase enforces these values via CHECK constraint",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "description": "Computed field (populated by repository layer - requires URL building)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Image"
+                    }
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
+                    }
+                },
+                "scale": {
+                    "type": "string"
+                },
+                "techniques": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
+                    }
+                },
+                "theme": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme"
+                        }
+                    ]
+                },
+                "themeId": {
+                    "type": "integer"
+                },
+                "timeSpent": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "miniatureProjectId": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "paint": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniaturePaint"
+                        }
+                    ]
+                },
+                "paintId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "miniatureProjectId": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "technique": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTechnique"
+                        }
+                    ]
+                },
+                "techniqueId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTechnique": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficultyLevel": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "coverImageFile": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
+                        }
+                    ]
+                },
+                "coverImageId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "miniatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.Skill": {
+            "type": "object",
+            "required": [
+                "skill",
+                "skillTypeId"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isVisible": {
+                    "type": "boolean"
+                },
+                "skill": {
+                    "type": "string"
+                },
+                "skillType": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.SkillType"
+                },
+                "skillTypeId": {
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Computed field",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.SkillType": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_portfolio-common_models.StorageFile": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "fileSize": {
+                    "type": "integer"
+                },
+                "fileType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mimeType": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Computed field",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.Certification": {
+            "type": "object",
+            "required": [
+                "issueDate",
+                "issuer",
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "credentialId": {
+                    "type": "string"
+                },
+                "credentialUrl": {
+                    "type": "string"
+                },
+                "expiryDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.Image": {
+            "type": "object",
+            "properties": {
+                "blurHash": {
+                    "type": "string"
+                },
+                "caption": {
+                    "type": "string"
+                },
+                "capturedAt": {
+                    "description": "Safe EXIF metadata. Width and Height and every rendition already have\nthe orientation applied; it is reported for reference only.",
+                    "type": "string"
+                },
+                "dominantColor": {
+                    "description": "#rrggbb",
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "orientation": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.ImageVariant"
+                    }
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.ImageVariant": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "description": "jpeg or webp",
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "thumb, medium or large",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "MIME type, e.g. image/jpeg",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.MiniatureDetail": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "description": "Computed field (populated by repository layer - requires URL building)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Image"
+                    }
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
+                    }
+                },
+                "scale": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "techniques": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
+                    }
+                },
+                "theme": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme"
+                },
+                "themeId": {
+                    "type": "integer"
+                },
+                "timeSpent": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "description": "Computed field (populated by repository layer - requires URL building)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Image"
+                    }
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
+                    }
+                },
+                "scale": {
+                    "type": "string"
+                },
+                "techniques": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
+                    }
+                },
+                "theme": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme"
+                },
+                "themeId": {
+                    "type": "integer"
+                },
+                "timeSpent": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "coverImageFile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.StorageFile"
+                },
+                "coverImageId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "miniatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.NavItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.Navigation": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.NavItem"
+                },
+                "previous": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.NavItem"
+                },
+                "related": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.RelatedItem"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.PageMeta": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.PaginatedResponse-github_com_GunarsK-portfolio_public-api_internal_models_PortfolioProject": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PaginationMeta"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.PaginationMeta": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.PaintMiniatures": {
+            "type": "object",
+            "properties": {
+                "miniatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
+                    }
+                },
+                "paint": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PaintWithUsage"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.PaintWithUsage": {
+            "type": "object",
+            "required": [
+                "manufacturer",
+                "name"
+            ],
+            "properties": {
+                "colorHex": {
+                    "description": "ColorHex is the hexadecimal color code in #RRGGBB or #RGB format (e.g., #FF5733, #F00)",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "miniatureCount": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
@@ -461,206 +2920,371 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.PortfolioBundle": {
+            "type": "object",
+            "properties": {
+                "certifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Certification"
+                    }
+                },
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "experience": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.WorkExperience"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Profile"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Skill"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "challenges": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "githubUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "imageFile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.StorageFile"
+                },
+                "imageFileId": {
+                    "type": "integer"
+                },
+                "isOngoing": {
+                    "type": "boolean"
+                },
+                "learnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "liveUrl": {
+                    "type": "string"
+                },
+                "longDescription": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "teamSize": {
+                    "type": "integer"
+                },
+                "technologies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Skill"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.Profile": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "completedDate": {
+                "avatarFile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.StorageFile"
+                },
+                "avatarFileId": {
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string"
                 },
+                "email": {
+                    "type": "string"
+                },
+                "github": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "linkedin": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "resumeFile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.StorageFile"
+                },
+                "resumeFileId": {
+                    "type": "integer"
+                },
+                "tagline": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_models.ProjectDetail": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "challenges": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "createdAt": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
-                "difficulty": {
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "githubUrl": {
                     "type": "string"
                 },
-                "displayOrder": {
+                "id": {
                     "type": "integer"
                 },
-                "id": {
+                "imageFile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.StorageFile"
+                },
+                "imageFileId": {
                     "type": "integer"
                 },
-                "images": {
-                    "description": "Computed field (populated by repository layer - requires URL building)",
+                "isOngoing": {
+                    "type": "boolean"
+                },
+                "learnings": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Image"
+                        "type": "string"
                     }
                 },
-                "manufacturer": {
+                "liveUrl": {
                     "type": "string"
                 },
-                "name": {
+                "longDescription": {
                     "type": "string"
                 },
-                "paints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
-                    }
+                "role": {
+                    "type": "string"
                 },
-                "scale": {
+                "slug": {
                     "type": "string"
                 },
-                "techniques": {
+                "startDate": {
+                    "type": "string"
+                },
+                "teamSize": {
+                    "type": "integer"
+                },
+                "technologies": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Skill"
                     }
                 },
-                "theme": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme"
-                        }
-                    ]
-                },
-                "themeId": {
-                    "type": "integer"
-                },
-                "timeSpent": {
-                    "type": "number"
+                "title": {
+                    "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.RelatedItem": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "miniatureProjectId": {
+                "image": {
+                    "type": "string"
+                },
+                "score": {
                     "type": "integer"
                 },
-                "notes": {
+                "slug": {
                     "type": "string"
                 },
-                "paint": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniaturePaint"
-                        }
-                    ]
-                },
-                "paintId": {
-                    "type": "integer"
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.SearchResult": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "miniatureProjectId": {
-                    "type": "integer"
+                "rank": {
+                    "type": "number"
                 },
-                "notes": {
+                "snippet": {
                     "type": "string"
                 },
-                "technique": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTechnique"
-                        }
-                    ]
+                "title": {
+                    "type": "string"
                 },
-                "techniqueId": {
-                    "type": "integer"
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTechnique": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.SimilarPaint": {
             "type": "object",
             "required": [
+                "manufacturer",
                 "name"
             ],
             "properties": {
-                "createdAt": {
+                "colorHex": {
+                    "description": "ColorHex is the hexadecimal color code in #RRGGBB or #RGB format (e.g., #FF5733, #F00)",
                     "type": "string"
                 },
-                "description": {
+                "createdAt": {
                     "type": "string"
                 },
-                "difficultyLevel": {
-                    "type": "string"
+                "deltaE": {
+                    "type": "number"
                 },
-                "displayOrder": {
+                "id": {
                     "type": "integer"
                 },
-                "id": {
+                "manufacturer": {
+                    "type": "string"
+                },
+                "miniatureCount": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
+                "paintType": {
+                    "description": "PaintType categorizes the paint (Base, Layer, Shade, Wash, Contrast, Dry, Technical, Metallic, Air, Primer, Edge, Glaze, Ink)\nDatabase enforces these values via CHECK constraint",
+                    "type": "string"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.SimilarPaints": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "coverImageFile": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                        }
-                    ]
-                },
-                "coverImageId": {
-                    "type": "integer"
-                },
-                "createdAt": {
+                "hex": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "displayOrder": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
                 "miniatures": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
                     }
                 },
-                "name": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
+                "paints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.SimilarPaint"
+                    }
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.Skill": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.Skill": {
             "type": "object",
             "required": [
                 "skill",
@@ -697,38 +3321,37 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.SkillType": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.SkillProjects": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "displayOrder": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject"
+                    }
                 },
-                "updatedAt": {
-                    "type": "string"
+                "skill": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.Skill"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_portfolio-common_models.StorageFile": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.StorageFile": {
             "type": "object",
             "properties": {
+                "blurHash": {
+                    "type": "string"
+                },
+                "capturedAt": {
+                    "description": "Safe EXIF metadata. Width and Height and every rendition already have\nthe orientation applied; it is reported for reference only.",
+                    "type": "string"
+                },
                 "createdAt": {
                     "type": "string"
                 },
+                "dominantColor": {
+                    "description": "#rrggbb",
+                    "type": "string"
+                },
                 "fileName": {
                     "type": "string"
                 },
@@ -738,139 +3361,87 @@ const docTemplate = `{
                 "fileType": {
                     "type": "string"
                 },
+                "height": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
                 "mimeType": {
                     "type": "string"
                 },
+                "orientation": {
+                    "type": "integer"
+                },
                 "url": {
                     "description": "Computed field",
                     "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.ImageVariant"
+                    }
+                },
+                "width": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.Certification": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.TechniqueMiniatures": {
             "type": "object",
-            "required": [
-                "issueDate",
-                "issuer",
-                "name"
-            ],
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "credentialId": {
-                    "type": "string"
-                },
-                "credentialUrl": {
-                    "type": "string"
-                },
-                "expiryDate": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "issueDate": {
-                    "type": "string"
-                },
-                "issuer": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
+                "miniatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
+                    }
                 },
-                "updatedAt": {
-                    "type": "string"
+                "technique": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.TechniqueWithUsage"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.TechniqueWithUsage": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "completedDate": {
-                    "type": "string"
-                },
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "difficulty": {
-                    "type": "string"
-                },
-                "displayOrder": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "images": {
-                    "description": "Computed field (populated by repository layer - requires URL building)",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Image"
-                    }
-                },
-                "manufacturer": {
-                    "type": "string"
-                },
-                "name": {
+                "description": {
                     "type": "string"
                 },
-                "paints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
-                    }
-                },
-                "scale": {
+                "difficultyLevel": {
                     "type": "string"
                 },
-                "techniques": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
-                    }
+                "displayOrder": {
+                    "type": "integer"
                 },
-                "theme": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme"
-                        }
-                    ]
+                "id": {
+                    "type": "integer"
                 },
-                "themeId": {
+                "miniatureCount": {
                     "type": "integer"
                 },
-                "timeSpent": {
-                    "type": "number"
+                "name": {
+                    "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.MiniatureTheme": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.ThemeDetail": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
                 "coverImageFile": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                        }
-                    ]
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.StorageFile"
                 },
                 "coverImageId": {
                     "type": "integer"
@@ -890,223 +3461,243 @@ const docTemplate = `{
                 "miniatures": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_models.MiniatureProject"
                     }
                 },
                 "name": {
                     "type": "string"
                 },
+                "slug": {
+                    "type": "string"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.PortfolioProject": {
+        "github_com_GunarsK-portfolio_public-api_internal_models.WorkExperience": {
             "type": "object",
             "required": [
-                "title"
+                "company",
+                "position",
+                "startDate"
             ],
             "properties": {
-                "category": {
+                "company": {
                     "type": "string"
                 },
-                "challenges": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
                 "createdAt": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
-                "displayOrder": {
-                    "type": "integer"
-                },
                 "endDate": {
                     "type": "string"
                 },
-                "featured": {
-                    "type": "boolean"
-                },
-                "features": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "githubUrl": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "imageFile": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                        }
-                    ]
-                },
-                "imageFileId": {
-                    "type": "integer"
-                },
-                "isOngoing": {
+                "isCurrent": {
                     "type": "boolean"
                 },
-                "learnings": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "position": {
+                    "type": "string"
                 },
-                "liveUrl": {
+                "startDate": {
                     "type": "string"
                 },
-                "longDescription": {
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Basics": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string"
                 },
-                "role": {
+                "image": {
                     "type": "string"
                 },
-                "startDate": {
+                "label": {
                     "type": "string"
                 },
-                "teamSize": {
-                    "type": "integer"
+                "location": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Location"
                 },
-                "technologies": {
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "profiles": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Skill"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Profile"
                     }
                 },
-                "title": {
-                    "type": "string"
-                },
-                "updatedAt": {
+                "summary": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.Profile": {
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Certificate": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "avatarFile": {
-                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                },
-                "avatarFileId": {
-                    "type": "integer"
-                },
-                "createdAt": {
+                "date": {
                     "type": "string"
                 },
-                "email": {
+                "issuer": {
                     "type": "string"
                 },
-                "github": {
+                "name": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "linkedin": {
+                "url": {
                     "type": "string"
-                },
-                "location": {
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Location": {
+            "type": "object",
+            "properties": {
+                "city": {
                     "type": "string"
                 },
-                "name": {
+                "region": {
                     "type": "string"
-                },
-                "phone": {
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Meta": {
+            "type": "object",
+            "properties": {
+                "lastModified": {
                     "type": "string"
                 },
-                "resumeFile": {
-                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                },
-                "resumeFileId": {
-                    "type": "integer"
-                },
-                "tagline": {
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Profile": {
+            "type": "object",
+            "properties": {
+                "network": {
                     "type": "string"
                 },
-                "title": {
+                "url": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.Skill": {
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Project": {
             "type": "object",
-            "required": [
-                "skill",
-                "skillTypeId"
-            ],
             "properties": {
-                "createdAt": {
+                "description": {
                     "type": "string"
                 },
-                "displayOrder": {
-                    "type": "integer"
+                "endDate": {
+                    "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "highlights": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "isVisible": {
-                    "type": "boolean"
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "skill": {
+                "name": {
                     "type": "string"
                 },
-                "skillType": {
-                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.SkillType"
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "skillTypeId": {
-                    "type": "integer"
+                "startDate": {
+                    "type": "string"
                 },
                 "type": {
-                    "description": "Computed field",
                     "type": "string"
                 },
-                "updatedAt": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_public-api_internal_models.WorkExperience": {
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Resume": {
             "type": "object",
-            "required": [
-                "company",
-                "position",
-                "startDate"
-            ],
             "properties": {
-                "company": {
+                "$schema": {
                     "type": "string"
                 },
-                "createdAt": {
-                    "type": "string"
+                "basics": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Basics"
                 },
-                "description": {
-                    "type": "string"
+                "certificates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Certificate"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Meta"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Project"
+                    }
                 },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Skill"
+                    }
+                },
+                "work": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_public-api_internal_resume.Work"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Skill": {
+            "type": "object",
+            "properties": {
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_public-api_internal_resume.Work": {
+            "type": "object",
+            "properties": {
                 "endDate": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "isCurrent": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
                 "position": {
                     "type": "string"
@@ -1114,7 +3705,7 @@ const docTemplate = `{
                 "startDate": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "summary": {
                     "type": "string"
                 }
             }
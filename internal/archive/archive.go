@@ -0,0 +1,111 @@
+// Package archive streams photo galleries as ZIP files that end with a JSON
+// manifest describing their contents.
+package archive
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/GunarsK-portfolio/public-api/internal/slug"
+)
+
+// ManifestName is the entry the manifest is written to, after all photos
+const ManifestName = "manifest.json"
+
+// Manifest describes a gallery: its miniatures with their paints, techniques
+// and photos in display order
+type Manifest struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description,omitempty"`
+	GeneratedAt time.Time   `json:"generatedAt"`
+	Miniatures  []Miniature `json:"miniatures"`
+}
+
+type Miniature struct {
+	ID            int64       `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description,omitempty"`
+	Scale         string      `json:"scale,omitempty"`
+	Manufacturer  string      `json:"manufacturer,omitempty"`
+	CompletedDate string      `json:"completedDate,omitempty"`
+	Paints        []Paint     `json:"paints"`
+	Techniques    []Technique `json:"techniques"`
+	Photos        []Photo     `json:"photos"`
+}
+
+type Paint struct {
+	Name         string `json:"name"`
+	Manufacturer string `json:"manufacturer"`
+	ColorHex     string `json:"colorHex,omitempty"`
+	PaintType    string `json:"paintType,omitempty"`
+	Notes        string `json:"notes,omitempty"`
+}
+
+type Technique struct {
+	Name  string `json:"name"`
+	Notes string `json:"notes,omitempty"`
+}
+
+// Photo is a picture of a miniature. File is its path in the archive and is
+// empty when the picture could not be included.
+type Photo struct {
+	File       string `json:"file,omitempty"`
+	Caption    string `json:"caption,omitempty"`
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	CapturedAt string `json:"capturedAt,omitempty"`
+}
+
+// Name builds a path segment from a 1-based position and a title, such as
+// "03-blood-angels", so that entries sort in display order
+func Name(position int, title, fallback string) string {
+	s := slug.Make(title)
+	if s == "" {
+		s = fallback
+	}
+	return fmt.Sprintf("%02d-%s", position, s)
+}
+
+// Writer writes photos to a ZIP stream one at a time, so only the photo being
+// written is held in memory. Photos are stored without compression since
+// JPEG data does not shrink further.
+type Writer struct {
+	zw *zip.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zw: zip.NewWriter(w)}
+}
+
+// AddPhoto writes data under name, which should be unique within the archive
+func (w *Writer) AddPhoto(name string, data []byte, modified time.Time) error {
+	entry, err := w.zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Modified: modified})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+	if _, err := entry.Write(data); err != nil {
+		return fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+	return nil
+}
+
+// Close writes the manifest and the ZIP directory. The underlying writer is
+// left open.
+func (w *Writer) Close(manifest Manifest) error {
+	entry, err := w.zw.CreateHeader(&zip.FileHeader{Name: ManifestName, Method: zip.Deflate, Modified: manifest.GeneratedAt})
+	if err != nil {
+		return fmt.Errorf("failed to add manifest to archive: %w", err)
+	}
+	encoder := json.NewEncoder(entry)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := w.zw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		position int
+		title    string
+		want     string
+	}{
+		{1, "Blood Angels", "01-blood-angels"},
+		{12, "Ærøskøbing", "12-aeroskobing"},
+		{3, "", "03-photo"},
+		{4, "!!!", "04-photo"},
+	}
+	for _, tt := range tests {
+		if got := Name(tt.position, tt.title, "photo"); got != tt.want {
+			t.Errorf("Name(%d, %q) = %q, want %q", tt.position, tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	modified := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	w := NewWriter(&buf)
+	if err := w.AddPhoto("01-dante/01-front.jpg", []byte("jpeg"), modified); err != nil {
+		t.Fatalf("AddPhoto() error = %v", err)
+	}
+	manifest := Manifest{
+		Title:       "Blood Angels",
+		GeneratedAt: modified,
+		Miniatures:  []Miniature{{ID: 1, Name: "Dante", Photos: []Photo{{File: "01-dante/01-front.jpg"}}}},
+	}
+	if err := w.Close(manifest); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("invalid archive: %v", err)
+	}
+	if len(reader.File) != 2 || reader.File[1].Name != ManifestName {
+		t.Fatalf("entries = %d, want the photo followed by the manifest", len(reader.File))
+	}
+
+	photo := reader.File[0]
+	if photo.Method != zip.Store || !photo.Modified.Equal(modified) {
+		t.Errorf("photo header = method %d, modified %v, want stored with its modification time", photo.Method, photo.Modified)
+	}
+	rc, err := photo.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := io.ReadAll(rc)
+	_ = rc.Close()
+	if string(data) != "jpeg" {
+		t.Errorf("photo = %q, want jpeg", data)
+	}
+
+	rc, err = reader.File[1].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = rc.Close() }()
+	var got Manifest
+	if err := json.NewDecoder(rc).Decode(&got); err != nil {
+		t.Fatalf("invalid manifest: %v", err)
+	}
+	if got.Title != "Blood Angels" || len(got.Miniatures) != 1 || got.Miniatures[0].Photos[0].File != "01-dante/01-front.jpg" {
+		t.Errorf("manifest = %+v", got)
+	}
+}
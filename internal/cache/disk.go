@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	diskHeaderSize  = 8 // Expiry timestamp stored before each value
+	diskCountersDir = "counters"
+)
+
+// DiskStore is a Store that keeps each entry in its own file under a directory,
+// for large values such as rendered images that should survive restarts.
+// Reads refresh the modification time, and when the entries outgrow maxBytes
+// the least recently used are removed until a tenth of the budget is free.
+// Counters written through Incr live in their own directory and are never evicted.
+type DiskStore struct {
+	dir      string
+	maxBytes int64
+
+	mu    sync.Mutex // Guards size and serializes counters
+	size  int64
+	now   func() time.Time
+	evict chan struct{} // Holds a token while an eviction pass runs
+}
+
+// NewDiskStore opens or creates the cache directory dir. A maxBytes of zero
+// or less leaves the size unbounded.
+func NewDiskStore(dir string, maxBytes int64) (*DiskStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	s := &DiskStore{dir: dir, maxBytes: maxBytes, now: time.Now, evict: make(chan struct{}, 1)}
+
+	entries, err := s.entries()
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		s.size += entry.size
+	}
+	return s, nil
+}
+
+func (s *DiskStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path := s.path(key)
+	data, err := os.ReadFile(path) //nolint:gosec // Path derived from a key digest
+	if errors.Is(err, fs.ErrNotExist) {
+		if counter, err := os.ReadFile(s.counterPath(key)); err == nil { //nolint:gosec // Path derived from a key digest
+			return counter, nil
+		}
+		return nil, ErrMiss
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache key %s: %w", key, err)
+	}
+	if len(data) < diskHeaderSize {
+		s.remove(path, int64(len(data)))
+		return nil, ErrMiss
+	}
+
+	expiresAt := time.Unix(0, int64(binary.BigEndian.Uint64(data))) //nolint:gosec // Written by Set
+	now := s.now()
+	if !now.Before(expiresAt) {
+		s.remove(path, int64(len(data)))
+		return nil, ErrMiss
+	}
+	_ = os.Chtimes(path, now, now)
+	return data[diskHeaderSize:], nil
+}
+
+func (s *DiskStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	data := make([]byte, diskHeaderSize+len(value))
+	binary.BigEndian.PutUint64(data, uint64(s.now().Add(ttl).UnixNano())) //nolint:gosec // Read back by Get
+	copy(data[diskHeaderSize:], value)
+
+	path := s.path(key)
+	previous := int64(0)
+	if info, err := os.Stat(path); err == nil {
+		previous = info.Size()
+	}
+	if err := s.write(path, data); err != nil {
+		return fmt.Errorf("failed to set cache key %s: %w", key, err)
+	}
+
+	s.mu.Lock()
+	s.size += int64(len(data)) - previous
+	over := s.maxBytes > 0 && s.size > s.maxBytes
+	s.mu.Unlock()
+	if over {
+		s.startEviction()
+	}
+	return nil
+}
+
+// Incr keeps counters as decimal files that never expire
+func (s *DiskStore) Incr(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.counterPath(key)
+	var counter int64
+	if data, err := os.ReadFile(path); err == nil { //nolint:gosec // Path derived from a key digest
+		counter, _ = strconv.ParseInt(string(data), 10, 64)
+	}
+	counter++
+
+	if err := s.write(path, strconv.AppendInt(nil, counter, 10)); err != nil {
+		return 0, fmt.Errorf("failed to increment cache key %s: %w", key, err)
+	}
+	return counter, nil
+}
+
+// path spreads entries over 256 subdirectories named after their key digest
+func (s *DiskStore) path(key string) string {
+	name := digest(key)
+	return filepath.Join(s.dir, name[:2], name)
+}
+
+func (s *DiskStore) counterPath(key string) string {
+	return filepath.Join(s.dir, diskCountersDir, digest(key))
+}
+
+func digest(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// write replaces the file at path atomically, so readers never see partial values
+func (s *DiskStore) write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (s *DiskStore) remove(path string, size int64) {
+	if err := os.Remove(path); err == nil {
+		s.mu.Lock()
+		s.size -= size
+		s.mu.Unlock()
+	}
+}
+
+// startEviction runs one eviction pass in the background unless one is running
+func (s *DiskStore) startEviction() {
+	select {
+	case s.evict <- struct{}{}:
+		go func() {
+			defer func() { <-s.evict }()
+			s.evictOldest()
+		}()
+	default:
+	}
+}
+
+// evictOldest removes the least recently used entries until the store is
+// at nine tenths of its budget
+func (s *DiskStore) evictOldest() {
+	entries, err := s.entries()
+	if err != nil {
+		return
+	}
+	slices.SortFunc(entries, func(a, b diskEntry) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	target := s.maxBytes - s.maxBytes/10
+	for _, entry := range entries {
+		s.mu.Lock()
+		done := s.size <= target
+		s.mu.Unlock()
+		if done {
+			return
+		}
+		s.remove(entry.path, entry.size)
+	}
+}
+
+type diskEntry struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// entries lists the evictable cache files, skipping counters and temporary
+// files of writes in progress
+func (s *DiskStore) entries() ([]diskEntry, error) {
+	var entries []diskEntry
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == diskCountersDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name()[0] == '.' {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil // Removed since the walk started
+		}
+		entries = append(entries, diskEntry{path: path, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan cache directory: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDiskStore_ExpiresEntries(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewDiskStore(dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore() error = %v", err)
+	}
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := store.Get(ctx, "key"); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get() error = %v, want ErrMiss before Set", err)
+	}
+	_ = store.Set(ctx, "key", []byte("value"), time.Minute)
+
+	// Entries survive a restart
+	reopened, err := NewDiskStore(dir, 0)
+	if err != nil {
+		t.Fatalf("NewDiskStore() error = %v", err)
+	}
+	reopened.now = store.now
+	if got, err := reopened.Get(ctx, "key"); err != nil || string(got) != "value" {
+		t.Fatalf("Get() = %q, %v; want value", got, err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := reopened.Get(ctx, "key"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get() error = %v, want ErrMiss after expiry", err)
+	}
+	if _, err := os.Stat(reopened.path("key")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expired entry file still exists: %v", err)
+	}
+}
+
+func TestDiskStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Each entry takes 108 bytes on disk, so the fourth one exceeds the budget
+	store, err := NewDiskStore(t.TempDir(), 350)
+	if err != nil {
+		t.Fatalf("NewDiskStore() error = %v", err)
+	}
+	ctx := context.Background()
+	value := bytes.Repeat([]byte("x"), 100)
+
+	now := time.Now()
+	for i, key := range []string{"a", "b", "c"} {
+		_ = store.Set(ctx, key, value, time.Hour)
+		touched := now.Add(time.Duration(i-3) * time.Minute)
+		_ = os.Chtimes(store.path(key), touched, touched)
+	}
+	// Reading "a" makes it the most recently used
+	store.now = func() time.Time { return now.Add(time.Minute) }
+	if _, err := store.Get(ctx, "a"); err != nil {
+		t.Fatalf("Get(a) error = %v", err)
+	}
+	store.now = time.Now
+	_ = store.Set(ctx, "d", value, time.Hour)
+
+	// Wait for the eviction pass to finish
+	store.evict <- struct{}{}
+	<-store.evict
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": false, "d": true} {
+		_, err := store.Get(ctx, key)
+		if got := err == nil; got != want {
+			t.Errorf("Get(%s) hit = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestDiskStore_CountersSurviveEviction(t *testing.T) {
+	store, err := NewDiskStore(t.TempDir(), 100)
+	if err != nil {
+		t.Fatalf("NewDiskStore() error = %v", err)
+	}
+	ctx := context.Background()
+
+	for want := int64(1); want <= 2; want++ {
+		if got, err := store.Incr(ctx, "version"); err != nil || got != want {
+			t.Fatalf("Incr() = %d, %v; want %d", got, err, want)
+		}
+	}
+	_ = store.Set(ctx, "big", bytes.Repeat([]byte("x"), 200), time.Hour)
+	store.evict <- struct{}{}
+	<-store.evict
+
+	if got, err := store.Get(ctx, "version"); err != nil || string(got) != "2" {
+		t.Errorf("Get(version) = %q, %v; want 2", got, err)
+	}
+	if _, err := store.Get(ctx, "big"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get(big) error = %v, want ErrMiss after eviction", err)
+	}
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMemoryStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	cacheMetrics := newTestMetrics()
+	store := NewMemoryStore(2, cacheMetrics)
+	ctx := context.Background()
+
+	_ = store.Set(ctx, "a", []byte("1"), time.Minute)
+	_ = store.Set(ctx, "b", []byte("2"), time.Minute)
+	// Touch "a" so "b" becomes the least recently used entry
+	if _, err := store.Get(ctx, "a"); err != nil {
+		t.Fatalf("Get(a) error = %v", err)
+	}
+	_ = store.Set(ctx, "c", []byte("3"), time.Minute)
+
+	if _, err := store.Get(ctx, "b"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get(b) error = %v, want ErrMiss after eviction", err)
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, err := store.Get(ctx, key); err != nil {
+			t.Errorf("Get(%s) error = %v, want hit", key, err)
+		}
+	}
+	if store.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", store.Len())
+	}
+	if got := testutil.ToFloat64(cacheMetrics.ExternalCallsTotal.WithLabelValues("memory_cache", "", "evicted")); got != 1 {
+		t.Errorf("evictions = %v, want 1", got)
+	}
+}
+
+func TestMemoryStore_ExpiresEntries(t *testing.T) {
+	store := NewMemoryStore(10, nil)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_ = store.Set(ctx, "key", []byte("value"), time.Minute)
+	if _, err := store.Get(ctx, "key"); err != nil {
+		t.Fatalf("Get() error = %v, want hit before expiry", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := store.Get(ctx, "key"); !errors.Is(err, ErrMiss) {
+		t.Errorf("Get() error = %v, want ErrMiss after expiry", err)
+	}
+	if store.Len() != 0 {
+		t.Errorf("Len() = %d, want expired entry removed", store.Len())
+	}
+}
+
+func TestMemoryStore_CountersSurviveEviction(t *testing.T) {
+	store := NewMemoryStore(1, nil)
+	ctx := context.Background()
+
+	if _, err := store.Incr(ctx, "version"); err != nil {
+		t.Fatalf("Incr() error = %v", err)
+	}
+	_ = store.Set(ctx, "a", []byte("1"), time.Minute)
+	_ = store.Set(ctx, "b", []byte("2"), time.Minute)
+
+	value, err := store.Get(ctx, "version")
+	if err != nil {
+		t.Fatalf("Get(version) error = %v", err)
+	}
+	if string(value) != "1" {
+		t.Errorf("Get(version) = %s, want 1", value)
+	}
+}
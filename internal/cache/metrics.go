@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/GunarsK-portfolio/portfolio-common/metrics"
+)
+
+// Cache names used in the metrics "service" label, e.g. "redis_cache"
+const (
+	MemoryCacheName = "memory"
+	RedisCacheName  = "redis"
+)
+
+// Outcomes recorded in the metrics "status" label
+const (
+	statusHit     = "hit"
+	statusMiss    = "miss"
+	statusEvicted = "evicted"
+)
+
+// recordLookup records a cache lookup with the service's metrics.Metrics
+// collector as an external call: service is "<cache>_cache", endpoint the
+// repository method and status hit or miss, timed up to the outcome.
+// A nil collector records nothing.
+func recordLookup(m *metrics.Metrics, cache, method, status string, duration time.Duration) {
+	if m != nil {
+		m.RecordExternalCall(cacheService(cache), method, status, duration)
+	}
+}
+
+// recordEviction counts an eviction as an external call with status evicted
+// and no endpoint, without a duration
+func recordEviction(m *metrics.Metrics, cache string) {
+	if m != nil {
+		m.ExternalCallsTotal.WithLabelValues(cacheService(cache), "", statusEvicted).Inc()
+	}
+}
+
+func cacheService(cache string) string {
+	return cache + "_cache"
+}
@@ -0,0 +1,395 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/GunarsK-portfolio/portfolio-common/metrics"
+	"github.com/GunarsK-portfolio/public-api/internal/config"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultKeyPrefix namespaces every key written by the caching repository
+const DefaultKeyPrefix = "public-api:cache"
+
+// sharedLoadTimeout bounds a collapsed load, which outlives the request that started it
+const sharedLoadTimeout = 30 * time.Second
+
+// errEncode marks values that cannot be serialized and therefore bypass the cache
+var errEncode = errors.New("failed to encode value for cache")
+
+// codec serializes cached values as JSON that ignores json tags, so fields kept
+// out of responses are cached too. Unlike gob it tells nil slices from empty
+// ones, so a value comes out of the cache exactly as the repository returned it.
+var codec = jsoniter.Config{TagKey: "cache"}.Froze()
+
+// Repository decorates a repository.Repository with a read-through cache.
+// Methods without an override below are passed straight to the wrapped repository.
+//
+// Concurrent misses for the same key are collapsed with singleflight so only one
+// caller reaches the wrapped repository; every caller decodes its own copy of the
+// result, so callers never share mutable values.
+//
+// Invalidation uses a key-version bump: every key embeds the current value of
+// "<prefix>:version", so incrementing that counter (Invalidate, or INCR from any
+// writer such as admin-api) orphans all cached entries at once and lets TTLs expire them.
+// The version is read from Options.VersionStore, so a layer over a private
+// store still sees bumps made in a shared one.
+type Repository struct {
+	repository.Repository
+	store   Store
+	version Store
+	ttls    config.CacheTTLs
+	prefix  string
+	name    string
+	metrics *metrics.Metrics
+	logger  *slog.Logger
+	group   singleflight.Group
+}
+
+// Options configures a caching Repository
+type Options struct {
+	Name    string           // Cache name in metrics labels, e.g. RedisCacheName
+	Metrics *metrics.Metrics // Optional collector for hits and misses
+	Logger  *slog.Logger
+
+	// VersionStore holds the key version, e.g. Redis under an in-process cache.
+	// Defaults to the cache store itself.
+	VersionStore Store
+}
+
+func NewRepository(inner repository.Repository, store Store, ttls config.CacheTTLs, opts Options) *Repository {
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	version := opts.VersionStore
+	if version == nil {
+		version = store
+	}
+	return &Repository{
+		Repository: inner,
+		store:      store,
+		version:    version,
+		ttls:       ttls,
+		prefix:     DefaultKeyPrefix,
+		name:       opts.Name,
+		metrics:    opts.Metrics,
+		logger:     logger,
+	}
+}
+
+// VersionKey returns the key whose value is embedded in every cache key
+func (r *Repository) VersionKey() string {
+	return r.prefix + ":version"
+}
+
+// Invalidate bumps the key version so every existing entry is ignored
+func (r *Repository) Invalidate(ctx context.Context) error {
+	if _, err := r.version.Incr(ctx, r.VersionKey()); err != nil {
+		return fmt.Errorf("failed to invalidate cache: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetProfile(ctx context.Context) (*models.Profile, error) {
+	return cached(ctx, r, "GetProfile", r.ttls.Profile, func(ctx context.Context) (*models.Profile, error) {
+		return r.Repository.GetProfile(ctx)
+	})
+}
+
+func (r *Repository) GetAllWorkExperience(ctx context.Context) ([]models.WorkExperience, error) {
+	return cached(ctx, r, "GetAllWorkExperience", r.ttls.Experience, func(ctx context.Context) ([]models.WorkExperience, error) {
+		return r.Repository.GetAllWorkExperience(ctx)
+	})
+}
+
+func (r *Repository) GetAllCertifications(ctx context.Context) ([]models.Certification, error) {
+	return cached(ctx, r, "GetAllCertifications", r.ttls.Certifications, func(ctx context.Context) ([]models.Certification, error) {
+		return r.Repository.GetAllCertifications(ctx)
+	})
+}
+
+func (r *Repository) GetAllSkills(ctx context.Context) ([]models.Skill, error) {
+	return cached(ctx, r, "GetAllSkills", r.ttls.Skills, func(ctx context.Context) ([]models.Skill, error) {
+		return r.Repository.GetAllSkills(ctx)
+	})
+}
+
+func (r *Repository) GetSkillGroups(ctx context.Context) ([]models.SkillGroup, error) {
+	return cached(ctx, r, "GetSkillGroups", r.ttls.Skills, func(ctx context.Context) ([]models.SkillGroup, error) {
+		return r.Repository.GetSkillGroups(ctx)
+	})
+}
+
+func (r *Repository) GetSkillProjects(ctx context.Context, id int64) (*models.SkillProjects, error) {
+	return cached(ctx, r, "GetSkillProjects", r.ttls.Projects, func(ctx context.Context) (*models.SkillProjects, error) {
+		return r.Repository.GetSkillProjects(ctx, id)
+	}, id)
+}
+
+func (r *Repository) GetAllProjects(ctx context.Context) ([]models.PortfolioProject, error) {
+	return cached(ctx, r, "GetAllProjects", r.ttls.Projects, func(ctx context.Context) ([]models.PortfolioProject, error) {
+		return r.Repository.GetAllProjects(ctx)
+	})
+}
+
+// projectPage bundles the two ListProjects results into one cacheable value
+type projectPage struct {
+	Projects []models.PortfolioProject
+	Total    int64
+}
+
+func (r *Repository) ListProjects(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+	page, err := cached(ctx, r, "ListProjects", r.ttls.Projects, func(ctx context.Context) (projectPage, error) {
+		projects, total, err := r.Repository.ListProjects(ctx, filter)
+		return projectPage{Projects: projects, Total: total}, err
+	}, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return page.Projects, page.Total, nil
+}
+
+func (r *Repository) GetProjectByID(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+	return cached(ctx, r, "GetProjectByID", r.ttls.Projects, func(ctx context.Context) (*models.PortfolioProject, error) {
+		return r.Repository.GetProjectByID(ctx, id)
+	}, id)
+}
+
+func (r *Repository) GetAllMiniatureProjects(ctx context.Context) ([]models.MiniatureProject, error) {
+	return cached(ctx, r, "GetAllMiniatureProjects", r.ttls.Miniatures, func(ctx context.Context) ([]models.MiniatureProject, error) {
+		return r.Repository.GetAllMiniatureProjects(ctx)
+	})
+}
+
+// miniaturePage bundles the two ListMiniatureProjects results into one cacheable value
+type miniaturePage struct {
+	Projects []models.MiniatureProject
+	Total    int64
+}
+
+func (r *Repository) ListMiniatureProjects(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+	page, err := cached(ctx, r, "ListMiniatureProjects", r.ttls.Miniatures, func(ctx context.Context) (miniaturePage, error) {
+		projects, total, err := r.Repository.ListMiniatureProjects(ctx, filter)
+		return miniaturePage{Projects: projects, Total: total}, err
+	}, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return page.Projects, page.Total, nil
+}
+
+func (r *Repository) GetMiniatureProjectByID(ctx context.Context, id int64) (*models.MiniatureProject, error) {
+	return cached(ctx, r, "GetMiniatureProjectByID", r.ttls.Miniatures, func(ctx context.Context) (*models.MiniatureProject, error) {
+		return r.Repository.GetMiniatureProjectByID(ctx, id)
+	}, id)
+}
+
+func (r *Repository) GetAllMiniatureThemes(ctx context.Context) ([]models.MiniatureTheme, error) {
+	return cached(ctx, r, "GetAllMiniatureThemes", r.ttls.Themes, func(ctx context.Context) ([]models.MiniatureTheme, error) {
+		return r.Repository.GetAllMiniatureThemes(ctx)
+	})
+}
+
+func (r *Repository) GetMiniatureThemeByID(ctx context.Context, id int64) (*models.MiniatureTheme, error) {
+	return cached(ctx, r, "GetMiniatureThemeByID", r.ttls.Themes, func(ctx context.Context) (*models.MiniatureTheme, error) {
+		return r.Repository.GetMiniatureThemeByID(ctx, id)
+	}, id)
+}
+
+func (r *Repository) GetAllPaints(ctx context.Context) ([]models.PaintWithUsage, error) {
+	return cached(ctx, r, "GetAllPaints", r.ttls.Miniatures, func(ctx context.Context) ([]models.PaintWithUsage, error) {
+		return r.Repository.GetAllPaints(ctx)
+	})
+}
+
+func (r *Repository) GetPaintMiniatures(ctx context.Context, id int64) (*models.PaintMiniatures, error) {
+	return cached(ctx, r, "GetPaintMiniatures", r.ttls.Miniatures, func(ctx context.Context) (*models.PaintMiniatures, error) {
+		return r.Repository.GetPaintMiniatures(ctx, id)
+	}, id)
+}
+
+func (r *Repository) GetAllTechniques(ctx context.Context) ([]models.TechniqueWithUsage, error) {
+	return cached(ctx, r, "GetAllTechniques", r.ttls.Miniatures, func(ctx context.Context) ([]models.TechniqueWithUsage, error) {
+		return r.Repository.GetAllTechniques(ctx)
+	})
+}
+
+func (r *Repository) GetTechniqueMiniatures(ctx context.Context, id int64) (*models.TechniqueMiniatures, error) {
+	return cached(ctx, r, "GetTechniqueMiniatures", r.ttls.Miniatures, func(ctx context.Context) (*models.TechniqueMiniatures, error) {
+		return r.Repository.GetTechniqueMiniatures(ctx, id)
+	}, id)
+}
+
+func (r *Repository) GetImageFile(ctx context.Context, id int64) (*models.StorageFile, error) {
+	return cached(ctx, r, "GetImageFile", r.ttls.Miniatures, func(ctx context.Context) (*models.StorageFile, error) {
+		return r.Repository.GetImageFile(ctx, id)
+	}, id)
+}
+
+// GetSitemapPages lists pages of all three kinds, so it keeps the shortest of their TTLs
+func (r *Repository) GetSitemapPages(ctx context.Context) (*models.SitemapPages, error) {
+	ttl := min(r.ttls.Projects, r.ttls.Themes, r.ttls.Miniatures)
+	return cached(ctx, r, "GetSitemapPages", ttl, func(ctx context.Context) (*models.SitemapPages, error) {
+		return r.Repository.GetSitemapPages(ctx)
+	})
+}
+
+func (r *Repository) GetProjectSlugs(ctx context.Context) (map[int64]string, error) {
+	return cached(ctx, r, "GetProjectSlugs", r.ttls.Projects, func(ctx context.Context) (map[int64]string, error) {
+		return r.Repository.GetProjectSlugs(ctx)
+	})
+}
+
+func (r *Repository) GetMiniatureSlugs(ctx context.Context) (map[int64]string, error) {
+	return cached(ctx, r, "GetMiniatureSlugs", r.ttls.Miniatures, func(ctx context.Context) (map[int64]string, error) {
+		return r.Repository.GetMiniatureSlugs(ctx)
+	})
+}
+
+func (r *Repository) GetThemeSlugs(ctx context.Context) (map[int64]string, error) {
+	return cached(ctx, r, "GetThemeSlugs", r.ttls.Themes, func(ctx context.Context) (map[int64]string, error) {
+		return r.Repository.GetThemeSlugs(ctx)
+	})
+}
+
+func (r *Repository) GetProjectBySlug(ctx context.Context, slug string) (*models.PortfolioProject, error) {
+	return cached(ctx, r, "GetProjectBySlug", r.ttls.Projects, func(ctx context.Context) (*models.PortfolioProject, error) {
+		return r.Repository.GetProjectBySlug(ctx, slug)
+	}, slug)
+}
+
+func (r *Repository) GetMiniatureProjectBySlug(ctx context.Context, slug string) (*models.MiniatureProject, error) {
+	return cached(ctx, r, "GetMiniatureProjectBySlug", r.ttls.Miniatures, func(ctx context.Context) (*models.MiniatureProject, error) {
+		return r.Repository.GetMiniatureProjectBySlug(ctx, slug)
+	}, slug)
+}
+
+func (r *Repository) GetMiniatureThemeBySlug(ctx context.Context, slug string) (*models.MiniatureTheme, error) {
+	return cached(ctx, r, "GetMiniatureThemeBySlug", r.ttls.Themes, func(ctx context.Context) (*models.MiniatureTheme, error) {
+		return r.Repository.GetMiniatureThemeBySlug(ctx, slug)
+	}, slug)
+}
+
+func (r *Repository) GetProjectNavigation(ctx context.Context, id int64, filter repository.ProjectFilter, relatedLimit int) (*models.Navigation, error) {
+	return cached(ctx, r, "GetProjectNavigation", r.ttls.Projects, func(ctx context.Context) (*models.Navigation, error) {
+		return r.Repository.GetProjectNavigation(ctx, id, filter, relatedLimit)
+	}, id, filter, relatedLimit)
+}
+
+func (r *Repository) GetMiniatureNavigation(ctx context.Context, id int64, filter repository.MiniatureFilter, relatedLimit int) (*models.Navigation, error) {
+	return cached(ctx, r, "GetMiniatureNavigation", r.ttls.Miniatures, func(ctx context.Context) (*models.Navigation, error) {
+		return r.Repository.GetMiniatureNavigation(ctx, id, filter, relatedLimit)
+	}, id, filter, relatedLimit)
+}
+
+// cached serves a method result from the store, falling back to load on a miss.
+// Store failures are logged and treated as misses so the cache never breaks reads.
+// Errors from load (including not-found) are returned as-is and never cached.
+func cached[T any](ctx context.Context, r *Repository, method string, ttl time.Duration, load func(ctx context.Context) (T, error), args ...any) (T, error) {
+	var zero T
+	if ttl <= 0 {
+		return load(ctx)
+	}
+
+	start := time.Now()
+	key, err := r.key(ctx, method, args...)
+	if err != nil {
+		r.logger.Warn("Cache key unavailable, bypassing cache", "method", method, "error", err)
+		return load(ctx)
+	}
+
+	data, err := r.store.Get(ctx, key)
+	if err == nil {
+		value, decodeErr := decode[T](data)
+		if decodeErr == nil {
+			recordLookup(r.metrics, r.name, method, statusHit, time.Since(start))
+			return value, nil
+		}
+		r.logger.Warn("Failed to decode cached value", "method", method, "error", decodeErr)
+	} else if !errors.Is(err, ErrMiss) {
+		r.logger.Warn("Cache read failed", "method", method, "error", err)
+	}
+	recordLookup(r.metrics, r.name, method, statusMiss, time.Since(start))
+
+	// The shared load must not be cancelled when the first caller goes away,
+	// since other callers may be waiting on the same result
+	result := r.group.DoChan(key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedLoadTimeout)
+		defer cancel()
+
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := codec.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errEncode, err)
+		}
+		if err := r.store.Set(loadCtx, key, data, ttl); err != nil {
+			r.logger.Warn("Cache write failed", "method", method, "error", err)
+		}
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-result:
+		if errors.Is(res.Err, errEncode) {
+			r.logger.Warn("Value not cacheable, bypassing cache", "method", method, "error", res.Err)
+			return load(ctx)
+		}
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return decode[T](res.Val.([]byte))
+	}
+}
+
+// decode deserializes a cached value
+func decode[T any](data []byte) (T, error) {
+	var value T
+	if err := codec.Unmarshal(data, &value); err != nil {
+		return value, fmt.Errorf("failed to decode cached value: %w", err)
+	}
+	return value, nil
+}
+
+// key builds "<prefix>:v<version>:<method>[:<args hash>]"
+func (r *Repository) key(ctx context.Context, method string, args ...any) (string, error) {
+	version := int64(0)
+	raw, err := r.version.Get(ctx, r.VersionKey())
+	switch {
+	case err == nil:
+		if version, err = strconv.ParseInt(string(raw), 10, 64); err != nil {
+			return "", fmt.Errorf("invalid cache version %q: %w", raw, err)
+		}
+	case !errors.Is(err, ErrMiss):
+		return "", err
+	}
+
+	key := fmt.Sprintf("%s:v%d:%s", r.prefix, version, method)
+	if len(args) == 0 {
+		return key, nil
+	}
+
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cache key arguments: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	return key + ":" + hex.EncodeToString(sum[:12]), nil
+}
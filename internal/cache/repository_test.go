@@ -0,0 +1,449 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/portfolio-common/metrics"
+	commonModels "github.com/GunarsK-portfolio/portfolio-common/models"
+	"github.com/GunarsK-portfolio/public-api/internal/config"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gorm.io/gorm"
+)
+
+// =============================================================================
+// In-Memory Fake Store
+// =============================================================================
+
+type fakeStore struct {
+	mu      sync.Mutex
+	data    map[string][]byte
+	failing bool
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{data: make(map[string][]byte)}
+}
+
+func (s *fakeStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return nil, errors.New("connection refused")
+	}
+	value, ok := s.data[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+	return value, nil
+}
+
+func (s *fakeStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return errors.New("connection refused")
+	}
+	s.data[key] = value
+	return nil
+}
+
+func (s *fakeStore) Incr(ctx context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return 0, errors.New("connection refused")
+	}
+	current, _ := strconv.ParseInt(string(s.data[key]), 10, 64)
+	current++
+	s.data[key] = []byte(strconv.FormatInt(current, 10))
+	return current, nil
+}
+
+// =============================================================================
+// Counting Inner Repository
+// =============================================================================
+
+// countingRepository embeds the interface so only the methods under test need bodies
+type countingRepository struct {
+	repository.Repository
+	calls          map[string]int
+	profile        *models.Profile
+	profileErr     error
+	skills         []models.Skill
+	projectsByPage map[int][]models.PortfolioProject
+	theme          *models.MiniatureTheme
+}
+
+func (r *countingRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
+	r.calls["GetProfile"]++
+	return r.profile, r.profileErr
+}
+
+func (r *countingRepository) GetAllSkills(ctx context.Context) ([]models.Skill, error) {
+	r.calls["GetAllSkills"]++
+	return r.skills, nil
+}
+
+func (r *countingRepository) GetSkillProjects(ctx context.Context, id int64) (*models.SkillProjects, error) {
+	r.calls["GetSkillProjects"]++
+	return &models.SkillProjects{Skill: models.Skill{ID: id}, Projects: []models.PortfolioProject{}}, nil
+}
+
+func (r *countingRepository) GetMiniatureThemeByID(ctx context.Context, id int64) (*models.MiniatureTheme, error) {
+	r.calls["GetMiniatureThemeByID"]++
+	return r.theme, nil
+}
+
+func (r *countingRepository) ListProjects(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+	r.calls["ListProjects"]++
+	return r.projectsByPage[filter.Page], 3, nil
+}
+
+func newCountingRepository() *countingRepository {
+	return &countingRepository{
+		calls: make(map[string]int),
+		profile: &models.Profile{
+			Profile: commonModels.Profile{
+				ID:       1,
+				FullName: "John Doe",
+			},
+			AvatarFile: &models.StorageFile{
+				StorageFile: commonModels.StorageFile{
+					ID:    5,
+					S3Key: "avatars/john.jpg",
+					URL:   "http://files/avatars/john.jpg",
+				},
+			},
+		},
+		skills: []models.Skill{},
+		projectsByPage: map[int][]models.PortfolioProject{
+			1: {{PortfolioProject: commonModels.PortfolioProject{ID: 1, Title: "First"}}},
+			2: {{PortfolioProject: commonModels.PortfolioProject{ID: 2, Title: "Second"}}},
+		},
+	}
+}
+
+// newTestMetrics builds the parts of metrics.Metrics the cache records into,
+// registered nowhere so tests can create as many as they like
+func newTestMetrics() *metrics.Metrics {
+	return &metrics.Metrics{
+		ExternalCallsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: "external_calls_total"},
+			[]string{"service", "endpoint", "status"},
+		),
+		ExternalCallDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "external_call_duration_seconds"},
+			[]string{"service", "endpoint"},
+		),
+	}
+}
+
+func testTTLs() config.CacheTTLs {
+	return config.CacheTTLs{
+		Profile:  time.Minute,
+		Skills:   time.Minute,
+		Projects: time.Minute,
+		Themes:   time.Minute,
+	}
+}
+
+// =============================================================================
+// Tests
+// =============================================================================
+
+func TestRepository_ServesHitsFromStore(t *testing.T) {
+	inner := newCountingRepository()
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		profile, err := repo.GetProfile(ctx)
+		if err != nil {
+			t.Fatalf("GetProfile() error = %v", err)
+		}
+		if profile.FullName != "John Doe" {
+			t.Errorf("GetProfile() name = %s, want John Doe", profile.FullName)
+		}
+		// Fields hidden from JSON must survive the round trip
+		if profile.AvatarFile == nil || profile.AvatarFile.S3Key != "avatars/john.jpg" {
+			t.Errorf("GetProfile() avatar = %+v, want S3 key preserved", profile.AvatarFile)
+		}
+	}
+
+	if inner.calls["GetProfile"] != 1 {
+		t.Errorf("inner GetProfile called %d times, want 1", inner.calls["GetProfile"])
+	}
+}
+
+func TestRepository_InvalidateBumpsVersion(t *testing.T) {
+	inner := newCountingRepository()
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+	ctx := context.Background()
+
+	if _, err := repo.GetProfile(ctx); err != nil {
+		t.Fatalf("GetProfile() error = %v", err)
+	}
+	if err := repo.Invalidate(ctx); err != nil {
+		t.Fatalf("Invalidate() error = %v", err)
+	}
+	if _, err := repo.GetProfile(ctx); err != nil {
+		t.Fatalf("GetProfile() error = %v", err)
+	}
+
+	if inner.calls["GetProfile"] != 2 {
+		t.Errorf("inner GetProfile called %d times, want 2 after invalidation", inner.calls["GetProfile"])
+	}
+}
+
+func TestRepository_ReadsVersionFromSharedStore(t *testing.T) {
+	inner := newCountingRepository()
+	shared := newFakeStore()
+	repo := NewRepository(inner, NewMemoryStore(10, nil), testTTLs(), Options{VersionStore: shared})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := repo.GetProfile(ctx); err != nil {
+			t.Fatalf("GetProfile() error = %v", err)
+		}
+	}
+	// A bump made by another writer in the shared store reaches the private cache
+	if _, err := shared.Incr(ctx, repo.VersionKey()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.GetProfile(ctx); err != nil {
+		t.Fatalf("GetProfile() error = %v", err)
+	}
+
+	if inner.calls["GetProfile"] != 2 {
+		t.Errorf("inner GetProfile called %d times, want 2 after the shared bump", inner.calls["GetProfile"])
+	}
+}
+
+func TestRepository_DoesNotCacheErrors(t *testing.T) {
+	inner := newCountingRepository()
+	inner.profile = nil
+	inner.profileErr = gorm.ErrRecordNotFound
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := repo.GetProfile(ctx); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("GetProfile() error = %v, want ErrRecordNotFound", err)
+		}
+	}
+
+	if inner.calls["GetProfile"] != 2 {
+		t.Errorf("inner GetProfile called %d times, want 2", inner.calls["GetProfile"])
+	}
+}
+
+func TestRepository_StoreFailureFallsBack(t *testing.T) {
+	inner := newCountingRepository()
+	store := newFakeStore()
+	store.failing = true
+	repo := NewRepository(inner, store, testTTLs(), Options{})
+
+	profile, err := repo.GetProfile(context.Background())
+	if err != nil {
+		t.Fatalf("GetProfile() error = %v, want fallback to inner repository", err)
+	}
+	if profile.ID != 1 {
+		t.Errorf("GetProfile() id = %d, want 1", profile.ID)
+	}
+}
+
+func TestRepository_KeepsEmptySlices(t *testing.T) {
+	inner := newCountingRepository()
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		skills, err := repo.GetAllSkills(ctx)
+		if err != nil {
+			t.Fatalf("GetAllSkills() error = %v", err)
+		}
+		if skills == nil {
+			t.Error("GetAllSkills() returned nil slice, want empty slice")
+		}
+	}
+}
+
+func TestRepository_KeepsNestedEmptySlices(t *testing.T) {
+	inner := newCountingRepository()
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		skill, err := repo.GetSkillProjects(ctx, 7)
+		if err != nil {
+			t.Fatalf("GetSkillProjects() error = %v", err)
+		}
+		if skill.Projects == nil {
+			t.Error("GetSkillProjects() returned nil projects, want empty slice")
+		}
+	}
+	if inner.calls["GetSkillProjects"] != 1 {
+		t.Errorf("inner GetSkillProjects called %d times, want 1", inner.calls["GetSkillProjects"])
+	}
+}
+
+func TestRepository_ReturnsValuesUnchanged(t *testing.T) {
+	inner := newCountingRepository()
+	completed := "2024-02-01"
+	file := &models.StorageFile{
+		StorageFile:  commonModels.StorageFile{ID: 9, S3Key: "images/front.jpg", MimeType: "image/jpeg"},
+		SourceURL:    "http://files/images/front.jpg",
+		ImageDetails: models.ImageDetails{Width: 800, Height: 600},
+		Variants:     []models.ImageVariant{{Name: "thumb", Width: 320, Height: 240, Format: "jpeg"}},
+	}
+	inner.theme = &models.MiniatureTheme{
+		MiniatureTheme: commonModels.MiniatureTheme{ID: 3, Name: "Blood Angels"},
+		Miniatures: []models.MiniatureProject{
+			{
+				MiniatureProject: commonModels.MiniatureProject{ID: 1, Title: "Dante", CompletedDate: &completed},
+				// Fields kept out of responses are still cached
+				MiniatureFiles: []models.MiniatureFile{{MiniatureFile: commonModels.MiniatureFile{ID: 4, Caption: "Front"}, File: file}},
+				Images:         []models.Image{},
+			},
+			// nil and empty slices stay as the repository returned them
+			{MiniatureProject: commonModels.MiniatureProject{ID: 2, Title: "Mephiston"}},
+		},
+	}
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+
+	for i := 0; i < 2; i++ {
+		theme, err := repo.GetMiniatureThemeByID(context.Background(), 3)
+		if err != nil {
+			t.Fatalf("GetMiniatureThemeByID() error = %v", err)
+		}
+		if !reflect.DeepEqual(theme, inner.theme) {
+			t.Errorf("GetMiniatureThemeByID() call %d = %+v, want %+v", i+1, theme, inner.theme)
+		}
+	}
+	if inner.calls["GetMiniatureThemeByID"] != 1 {
+		t.Errorf("inner GetMiniatureThemeByID called %d times, want 1", inner.calls["GetMiniatureThemeByID"])
+	}
+}
+
+func TestRepository_KeysIncludeArguments(t *testing.T) {
+	inner := newCountingRepository()
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+	ctx := context.Background()
+
+	first, _, err := repo.ListProjects(ctx, repository.ProjectFilter{Pagination: repository.Pagination{Page: 1, Limit: 1}})
+	if err != nil {
+		t.Fatalf("ListProjects() error = %v", err)
+	}
+	second, total, err := repo.ListProjects(ctx, repository.ProjectFilter{Pagination: repository.Pagination{Page: 2, Limit: 1}})
+	if err != nil {
+		t.Fatalf("ListProjects() error = %v", err)
+	}
+	if _, _, err := repo.ListProjects(ctx, repository.ProjectFilter{Pagination: repository.Pagination{Page: 2, Limit: 1}}); err != nil {
+		t.Fatalf("ListProjects() error = %v", err)
+	}
+
+	if first[0].Title != "First" || second[0].Title != "Second" {
+		t.Errorf("ListProjects() pages = %s/%s, want First/Second", first[0].Title, second[0].Title)
+	}
+	if total != 3 {
+		t.Errorf("ListProjects() total = %d, want 3", total)
+	}
+	if inner.calls["ListProjects"] != 2 {
+		t.Errorf("inner ListProjects called %d times, want 2", inner.calls["ListProjects"])
+	}
+}
+
+func TestRepository_ZeroTTLBypassesCache(t *testing.T) {
+	inner := newCountingRepository()
+	repo := NewRepository(inner, newFakeStore(), config.CacheTTLs{}, Options{})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := repo.GetProfile(ctx); err != nil {
+			t.Fatalf("GetProfile() error = %v", err)
+		}
+	}
+
+	if inner.calls["GetProfile"] != 2 {
+		t.Errorf("inner GetProfile called %d times, want 2 with caching disabled", inner.calls["GetProfile"])
+	}
+}
+
+// blockingRepository holds every GetProfile call until release is closed
+type blockingRepository struct {
+	repository.Repository
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (r *blockingRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
+	r.calls.Add(1)
+	<-r.release
+	return &models.Profile{Profile: commonModels.Profile{ID: 1, FullName: "John Doe"}}, nil
+}
+
+func TestRepository_CollapsesConcurrentMisses(t *testing.T) {
+	inner := &blockingRepository{release: make(chan struct{})}
+	repo := NewRepository(inner, newFakeStore(), testTTLs(), Options{})
+
+	const callers = 20
+	var wg sync.WaitGroup
+	profiles := make([]*models.Profile, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			profiles[i], errs[i] = repo.GetProfile(context.Background())
+		}(i)
+	}
+
+	// Give every caller time to join the in-flight load before releasing it
+	time.Sleep(50 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+
+	if got := inner.calls.Load(); got != 1 {
+		t.Errorf("inner GetProfile called %d times, want 1", got)
+	}
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil {
+			t.Fatalf("GetProfile() caller %d error = %v", i, errs[i])
+		}
+		if i > 0 && profiles[i] == profiles[0] {
+			t.Error("callers should receive independent copies, not a shared pointer")
+		}
+	}
+}
+
+func TestRepository_RecordsHitsAndMisses(t *testing.T) {
+	cacheMetrics := newTestMetrics()
+	repo := NewRepository(newCountingRepository(), newFakeStore(), testTTLs(), Options{
+		Name:    RedisCacheName,
+		Metrics: cacheMetrics,
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := repo.GetProfile(ctx); err != nil {
+			t.Fatalf("GetProfile() error = %v", err)
+		}
+	}
+
+	if got := testutil.ToFloat64(cacheMetrics.ExternalCallsTotal.WithLabelValues("redis_cache", "GetProfile", "miss")); got != 1 {
+		t.Errorf("misses = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(cacheMetrics.ExternalCallsTotal.WithLabelValues("redis_cache", "GetProfile", "hit")); got != 2 {
+		t.Errorf("hits = %v, want 2", got)
+	}
+}
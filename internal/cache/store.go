@@ -0,0 +1,18 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrMiss is returned by a Store when the key is not present
+var ErrMiss = errors.New("cache miss")
+
+// Store is the key/value backend used by the caching repository.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Incr(ctx context.Context, key string) (int64, error)
+}
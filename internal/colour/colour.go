@@ -0,0 +1,153 @@
+// Package colour parses hex colours and compares them perceptually using
+// CIELAB coordinates and the CIEDE2000 colour difference formula.
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidHex is returned for strings that are not #RGB or #RRGGBB colours
+var ErrInvalidHex = errors.New("invalid hex colour")
+
+// RGB is an 8-bit sRGB colour
+type RGB struct {
+	R, G, B uint8
+}
+
+// Lab is a CIELAB colour under the D65 illuminant
+type Lab struct {
+	L, A, B float64
+}
+
+// D65 reference white
+const (
+	whiteX = 0.95047
+	whiteY = 1.0
+	whiteZ = 1.08883
+)
+
+// ParseHex parses #RGB or #RRGGBB, case-insensitively and with the # optional
+func ParseHex(s string) (RGB, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return RGB{}, fmt.Errorf("%w: %q", ErrInvalidHex, s)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return RGB{}, fmt.Errorf("%w: %q", ErrInvalidHex, s)
+	}
+	return RGB{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v)}, nil
+}
+
+// Hex formats the colour as lowercase #rrggbb
+func (c RGB) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// Lab converts the sRGB colour to CIELAB
+func (c RGB) Lab() Lab {
+	r, g, b := linearize(c.R), linearize(c.G), linearize(c.B)
+	x := (0.4124564*r + 0.3575761*g + 0.1804375*b) / whiteX
+	y := (0.2126729*r + 0.7151522*g + 0.0721750*b) / whiteY
+	z := (0.0193339*r + 0.1191920*g + 0.9503041*b) / whiteZ
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return Lab{L: 116*fy - 16, A: 500 * (fx - fy), B: 200 * (fy - fz)}
+}
+
+// linearize removes the sRGB transfer curve from an 8-bit channel
+func linearize(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func labF(t float64) float64 {
+	const delta = 6.0 / 29
+	if t > delta*delta*delta {
+		return math.Cbrt(t)
+	}
+	return t/(3*delta*delta) + 4.0/29
+}
+
+// DeltaE2000 returns the CIEDE2000 difference between two colours with the
+// parametric weights kL, kC and kH set to 1. A difference below about 2 is
+// hard to see; above 10 the colours read as clearly different.
+func DeltaE2000(c1, c2 Lab) float64 {
+	pow25to7 := math.Pow(25, 7)
+
+	// Rescale a* to correct hue for near-neutral colours
+	cBar := (math.Hypot(c1.A, c1.B) + math.Hypot(c2.A, c2.B)) / 2
+	g := 0.5 * (1 - math.Sqrt(math.Pow(cBar, 7)/(math.Pow(cBar, 7)+pow25to7)))
+	a1, a2 := (1+g)*c1.A, (1+g)*c2.A
+	cp1, cp2 := math.Hypot(a1, c1.B), math.Hypot(a2, c2.B)
+	hp1, hp2 := hueAngle(c1.B, a1), hueAngle(c2.B, a2)
+
+	dL := c2.L - c1.L
+	dC := cp2 - cp1
+	dh := 0.0
+	if cp1*cp2 != 0 {
+		dh = hp2 - hp1
+		switch {
+		case dh > 180:
+			dh -= 360
+		case dh < -180:
+			dh += 360
+		}
+	}
+	dH := 2 * math.Sqrt(cp1*cp2) * math.Sin(radians(dh/2))
+
+	lBar := (c1.L + c2.L) / 2
+	cpBar := (cp1 + cp2) / 2
+	hBar := hp1 + hp2
+	if cp1*cp2 != 0 {
+		switch {
+		case math.Abs(hp1-hp2) <= 180:
+			hBar /= 2
+		case hBar < 360:
+			hBar = (hBar + 360) / 2
+		default:
+			hBar = (hBar - 360) / 2
+		}
+	}
+
+	t := 1 - 0.17*math.Cos(radians(hBar-30)) +
+		0.24*math.Cos(radians(2*hBar)) +
+		0.32*math.Cos(radians(3*hBar+6)) -
+		0.20*math.Cos(radians(4*hBar-63))
+	dTheta := 30 * math.Exp(-math.Pow((hBar-275)/25, 2))
+	rC := 2 * math.Sqrt(math.Pow(cpBar, 7)/(math.Pow(cpBar, 7)+pow25to7))
+	lDev := (lBar - 50) * (lBar - 50)
+	sL := 1 + 0.015*lDev/math.Sqrt(20+lDev)
+	sC := 1 + 0.045*cpBar
+	sH := 1 + 0.015*cpBar*t
+	rT := -math.Sin(radians(2*dTheta)) * rC
+
+	l, c, h := dL/sL, dC/sC, dH/sH
+	return math.Sqrt(l*l + c*c + h*h + rT*c*h)
+}
+
+// hueAngle returns atan2(b, a) in degrees within [0, 360)
+func hueAngle(b, a float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
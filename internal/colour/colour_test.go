@@ -0,0 +1,83 @@
+package colour
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseHex(t *testing.T) {
+	tests := []struct {
+		in   string
+		want RGB
+	}{
+		{"#aabbcc", RGB{0xaa, 0xbb, 0xcc}},
+		{"AABBCC", RGB{0xaa, 0xbb, 0xcc}},
+		{"#F00", RGB{0xff, 0x00, 0x00}},
+		{" #1b3a6b ", RGB{0x1b, 0x3a, 0x6b}},
+	}
+	for _, tt := range tests {
+		got, err := ParseHex(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseHex(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if got := (RGB{0xAA, 0x0B, 0xCC}).Hex(); got != "#aa0bcc" {
+		t.Errorf("Hex() = %q, want #aa0bcc", got)
+	}
+
+	for _, in := range []string{"", "#", "#ab", "#abcd", "#gggggg", "#-12345", "#aabbccdd"} {
+		if _, err := ParseHex(in); !errors.Is(err, ErrInvalidHex) {
+			t.Errorf("ParseHex(%q) error = %v, want ErrInvalidHex", in, err)
+		}
+	}
+}
+
+func TestLab(t *testing.T) {
+	tests := []struct {
+		in   RGB
+		want Lab
+	}{
+		{RGB{0xff, 0xff, 0xff}, Lab{100, 0, 0}},
+		{RGB{0, 0, 0}, Lab{0, 0, 0}},
+		{RGB{0xff, 0, 0}, Lab{53.24, 80.09, 67.20}},
+		{RGB{0, 0, 0xff}, Lab{32.30, 79.19, -107.86}},
+	}
+	for _, tt := range tests {
+		got := tt.in.Lab()
+		if math.Abs(got.L-tt.want.L) > 0.01 || math.Abs(got.A-tt.want.A) > 0.01 || math.Abs(got.B-tt.want.B) > 0.01 {
+			t.Errorf("%s.Lab() = %+v, want %+v", tt.in.Hex(), got, tt.want)
+		}
+	}
+}
+
+// Reference pairs from Sharma, Wu and Dalal, "The CIEDE2000 Color-Difference
+// Formula: Implementation Notes, Supplementary Test Data, and Mathematical Observations"
+func TestDeltaE2000(t *testing.T) {
+	tests := []struct {
+		c1, c2 Lab
+		want   float64
+	}{
+		{Lab{50, 2.6772, -79.7751}, Lab{50, 0, -82.7485}, 2.0425},
+		{Lab{50, 0, 0}, Lab{50, -1, 2}, 2.3669},
+		{Lab{50, -1, 2}, Lab{50, 0, 0}, 2.3669},
+		{Lab{50, 2.49, -0.001}, Lab{50, -2.49, 0.0009}, 7.1792},
+		{Lab{50, 2.49, -0.001}, Lab{50, -2.49, 0.0011}, 7.2195},
+		{Lab{50, 2.49, -0.001}, Lab{50, -2.49, 0.0012}, 7.2195},
+		{Lab{50, 2.5, 0}, Lab{73, 25, -18}, 27.1492},
+		{Lab{50, 2.5, 0}, Lab{56, -27, -3}, 31.9030},
+		{Lab{60.2574, -34.0099, 36.2677}, Lab{60.4626, -34.1751, 39.4387}, 1.2644},
+		{Lab{22.7233, 20.0904, -46.694}, Lab{23.0331, 14.973, -42.5619}, 2.0373},
+		{Lab{90.9257, -0.5406, -0.9208}, Lab{88.6381, -0.8985, -0.7239}, 1.5381},
+		{Lab{2.0776, 0.0795, -1.135}, Lab{0.9033, -0.0636, -0.5514}, 0.9082},
+	}
+	for _, tt := range tests {
+		if got := DeltaE2000(tt.c1, tt.c2); math.Abs(got-tt.want) > 0.0001 {
+			t.Errorf("DeltaE2000(%+v, %+v) = %.4f, want %.4f", tt.c1, tt.c2, got, tt.want)
+		}
+	}
+
+	if got := DeltaE2000(Lab{40, 10, -5}, Lab{40, 10, -5}); got != 0 {
+		t.Errorf("DeltaE2000(same) = %v, want 0", got)
+	}
+}
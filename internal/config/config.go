@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 
@@ -12,6 +14,49 @@ type Config struct {
 	common.DatabaseConfig
 	common.ServiceConfig
 	FilesAPIURL string `validate:"required,url"`
+
+	// PublicSiteURL is the public website origin used for absolute links (vCard, QR codes, feeds, sitemap)
+	PublicSiteURL string `validate:"omitempty,url"`
+
+	// PublicAPIURL is the public base URL of this API (including /api/v1). Images are
+	// only ever linked through its image endpoint, so their metadata never reaches clients.
+	PublicAPIURL string `validate:"required,url"`
+
+	// HTTPCacheMaxAge is the Cache-Control max-age sent with public content responses
+	HTTPCacheMaxAge time.Duration `validate:"min=0"`
+
+	Cache  CacheConfig
+	Images ImageConfig
+}
+
+// ImageConfig controls the image endpoint
+type ImageConfig struct {
+	SigningKey string `validate:"omitempty,min=32"` // Allows signed renditions beyond the variant widths
+	CacheDir   string // Rendered images are cached in process when empty
+	CacheMaxMB int    `validate:"min=0"`
+}
+
+// CacheConfig controls the optional repository caches.
+// The in-process cache sits in front of Redis when both are enabled.
+type CacheConfig struct {
+	RedisEnabled bool
+	Redis        *common.RedisConfig // Loaded only when RedisEnabled is set
+	TTLs         CacheTTLs
+
+	MemoryEnabled    bool
+	MemoryMaxEntries int           `validate:"min=1"`
+	MemoryTTL        time.Duration `validate:"min=0"` // Upper bound for in-process TTLs
+}
+
+// CacheTTLs holds per-resource cache lifetimes for repository reads
+type CacheTTLs struct {
+	Profile        time.Duration `validate:"min=0"`
+	Experience     time.Duration `validate:"min=0"`
+	Certifications time.Duration `validate:"min=0"`
+	Skills         time.Duration `validate:"min=0"`
+	Projects       time.Duration `validate:"min=0"`
+	Miniatures     time.Duration `validate:"min=0"`
+	Themes         time.Duration `validate:"min=0"`
 }
 
 func Load() *Config {
@@ -19,6 +64,17 @@ func Load() *Config {
 		DatabaseConfig: common.NewDatabaseConfig(),
 		ServiceConfig:  common.NewServiceConfig(8082),
 		FilesAPIURL:    common.GetEnvRequired("FILES_API_URL"),
+		PublicSiteURL:  strings.TrimSuffix(common.GetEnv("PUBLIC_SITE_URL", ""), "/"),
+		PublicAPIURL:   strings.TrimSuffix(common.GetEnv("PUBLIC_API_URL", ""), "/"),
+
+		HTTPCacheMaxAge: common.GetEnvDuration("HTTP_CACHE_MAX_AGE", 5*time.Minute),
+
+		Cache: loadCacheConfig(),
+		Images: ImageConfig{
+			SigningKey: common.GetEnv("IMAGE_SIGNING_KEY", ""),
+			CacheDir:   common.GetEnv("IMAGE_CACHE_DIR", ""),
+			CacheMaxMB: common.GetEnvInt("IMAGE_CACHE_MAX_MB", 1024),
+		},
 	}
 
 	// Validate service-specific fields
@@ -29,3 +85,47 @@ func Load() *Config {
 
 	return cfg
 }
+
+func loadCacheConfig() CacheConfig {
+	defaultTTL := common.GetEnvDuration("CACHE_TTL_DEFAULT", 10*time.Minute)
+
+	cfg := CacheConfig{
+		RedisEnabled: common.GetEnvBool("CACHE_REDIS_ENABLED", false),
+		TTLs: CacheTTLs{
+			Profile:        common.GetEnvDuration("CACHE_TTL_PROFILE", defaultTTL),
+			Experience:     common.GetEnvDuration("CACHE_TTL_EXPERIENCE", defaultTTL),
+			Certifications: common.GetEnvDuration("CACHE_TTL_CERTIFICATIONS", defaultTTL),
+			Skills:         common.GetEnvDuration("CACHE_TTL_SKILLS", defaultTTL),
+			Projects:       common.GetEnvDuration("CACHE_TTL_PROJECTS", defaultTTL),
+			Miniatures:     common.GetEnvDuration("CACHE_TTL_MINIATURES", defaultTTL),
+			Themes:         common.GetEnvDuration("CACHE_TTL_THEMES", defaultTTL),
+		},
+
+		MemoryEnabled:    common.GetEnvBool("CACHE_MEMORY_ENABLED", false),
+		MemoryMaxEntries: common.GetEnvInt("CACHE_MEMORY_MAX_ENTRIES", 1000),
+		MemoryTTL:        common.GetEnvDuration("CACHE_MEMORY_TTL", time.Minute),
+	}
+
+	if cfg.RedisEnabled {
+		redisCfg := common.NewRedisConfig()
+		cfg.Redis = &redisCfg
+	}
+
+	return cfg
+}
+
+// Capped returns a copy of the TTLs where every value is at most limit
+func (t CacheTTLs) Capped(limit time.Duration) CacheTTLs {
+	capTTL := func(ttl time.Duration) time.Duration {
+		return min(ttl, limit)
+	}
+	return CacheTTLs{
+		Profile:        capTTL(t.Profile),
+		Experience:     capTTL(t.Experience),
+		Certifications: capTTL(t.Certifications),
+		Skills:         capTTL(t.Skills),
+		Projects:       capTTL(t.Projects),
+		Miniatures:     capTTL(t.Miniatures),
+		Themes:         capTTL(t.Themes),
+	}
+}
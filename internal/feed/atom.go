@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   *atomPerson `xml:"author"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length string `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+// Atom renders the feed as an Atom 1.0 (RFC 4287) document
+func (f Feed) Atom() ([]byte, error) {
+	doc := atomFeed{
+		Xmlns:    atomNamespace,
+		ID:       f.FeedURL,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  f.Updated().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Href: f.FeedURL, Type: "application/atom+xml"},
+			{Rel: "alternate", Href: f.Link, Type: "text/html"},
+		},
+	}
+	// Atom requires an author on the feed when entries have none
+	doc.Author = &atomPerson{Name: f.Author}
+	if f.Author == "" {
+		doc.Author.Name = f.Title
+	}
+
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:        item.Link,
+			Title:     item.Title,
+			Links:     []atomLink{{Rel: "alternate", Href: item.Link, Type: "text/html"}},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.updated().UTC().Format(time.RFC3339),
+			Summary:   item.Summary,
+		}
+		if item.Image != nil {
+			link := atomLink{Rel: "enclosure", Href: item.Image.URL, Type: item.Image.MimeType}
+			if item.Image.Length > 0 {
+				link.Length = strconv.FormatInt(item.Image.Length, 10)
+			}
+			entry.Links = append(entry.Links, link)
+		}
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshalXML(doc)
+}
+
+// marshalXML encodes an XML document with its declaration
+func marshalXML(doc any) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode feed: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
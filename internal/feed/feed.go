@@ -0,0 +1,76 @@
+// Package feed renders recently added portfolio content as Atom, RSS 2.0 and JSON Feed documents.
+package feed
+
+import (
+	"sort"
+	"time"
+)
+
+// Content types for each feed format
+const (
+	AtomContentType = "application/atom+xml; charset=utf-8"
+	RSSContentType  = "application/rss+xml; charset=utf-8"
+	JSONContentType = "application/feed+json; charset=utf-8"
+)
+
+// Feed is a format-independent list of entries
+type Feed struct {
+	Title       string
+	Description string
+	Link        string // Public page the feed describes
+	FeedURL     string // Absolute URL of the feed document itself
+	Author      string
+	Items       []Item
+}
+
+// Item is a single feed entry. Link doubles as the entry's permanent ID.
+type Item struct {
+	Title      string
+	Summary    string
+	Link       string
+	Categories []string
+	Image      *Enclosure
+	Published  time.Time
+	Updated    time.Time
+}
+
+// Enclosure is an absolute media URL attached to an entry
+type Enclosure struct {
+	URL      string
+	MimeType string
+	Length   int64
+}
+
+// SortNewest orders items by publication time, newest first, and keeps at most limit of them
+func SortNewest(items []Item, limit int) []Item {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Published.After(items[j].Published)
+	})
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
+
+// Updated returns the most recent update time across all items.
+// An empty feed reports the Unix epoch so the document stays valid and stable.
+func (f Feed) Updated() time.Time {
+	var updated time.Time
+	for _, item := range f.Items {
+		if item.updated().After(updated) {
+			updated = item.updated()
+		}
+	}
+	if updated.IsZero() {
+		return time.Unix(0, 0).UTC()
+	}
+	return updated.UTC()
+}
+
+// updated falls back to the publication time for items that were never modified
+func (i Item) updated() time.Time {
+	if i.Updated.Before(i.Published) {
+		return i.Published
+	}
+	return i.Updated
+}
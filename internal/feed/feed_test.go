@@ -0,0 +1,147 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed() Feed {
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	return Feed{
+		Title:       "John Doe - Portfolio",
+		Description: "Recently added projects and miniatures",
+		Link:        "https://example.com",
+		FeedURL:     "https://api.example.com/api/v1/feed.atom",
+		Author:      "John Doe",
+		Items: []Item{
+			{
+				Title:      "Portfolio <API>",
+				Summary:    "Go & Vue",
+				Link:       "https://example.com/projects/1",
+				Categories: []string{"project", "Go"},
+				Image:      &Enclosure{URL: "http://files/images/1.png", MimeType: "image/png", Length: 2048},
+				Published:  created,
+				Updated:    created.Add(48 * time.Hour),
+			},
+			{
+				Title:     "Space Marine",
+				Link:      "https://example.com/miniatures/2",
+				Published: created.Add(-24 * time.Hour),
+			},
+		},
+	}
+}
+
+func TestAtom(t *testing.T) {
+	body, err := testFeed().Atom()
+	if err != nil {
+		t.Fatalf("Atom() error = %v", err)
+	}
+
+	var doc atomFeed
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Atom() produced invalid XML: %v\n%s", err, body)
+	}
+	if doc.Xmlns != atomNamespace {
+		t.Errorf("namespace = %q, want %q", doc.Xmlns, atomNamespace)
+	}
+	if doc.Updated != "2024-03-03T10:00:00Z" {
+		t.Errorf("feed updated = %s, want newest entry update", doc.Updated)
+	}
+	if len(doc.Entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(doc.Entries))
+	}
+	if doc.Entries[0].Title != "Portfolio <API>" || doc.Entries[0].ID != "https://example.com/projects/1" {
+		t.Errorf("entry = %+v, want escaped title round trip and link as ID", doc.Entries[0])
+	}
+	// Entries never modified report their publication time as updated
+	if doc.Entries[1].Updated != doc.Entries[1].Published {
+		t.Errorf("entry updated = %s, want published %s", doc.Entries[1].Updated, doc.Entries[1].Published)
+	}
+	if len(doc.Entries[0].Links) != 2 || doc.Entries[0].Links[1].Rel != "enclosure" || doc.Entries[0].Links[1].Length != "2048" {
+		t.Errorf("entry links = %+v, want alternate and enclosure", doc.Entries[0].Links)
+	}
+}
+
+func TestRSS(t *testing.T) {
+	body, err := testFeed().RSS()
+	if err != nil {
+		t.Fatalf("RSS() error = %v", err)
+	}
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			LastBuildDate string `xml:"lastBuildDate"`
+			Items         []struct {
+				GUID      string `xml:"guid"`
+				PubDate   string `xml:"pubDate"`
+				Enclosure *struct {
+					URL    string `xml:"url,attr"`
+					Length int64  `xml:"length,attr"`
+					Type   string `xml:"type,attr"`
+				} `xml:"enclosure"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("RSS() produced invalid XML: %v\n%s", err, body)
+	}
+	if doc.Version != "2.0" || len(doc.Channel.Items) != 2 {
+		t.Fatalf("RSS() = version %s with %d items, want 2.0 with 2", doc.Version, len(doc.Channel.Items))
+	}
+	if doc.Channel.Items[0].PubDate != "Fri, 01 Mar 2024 10:00:00 +0000" {
+		t.Errorf("pubDate = %s, want RFC 1123 with numeric zone", doc.Channel.Items[0].PubDate)
+	}
+	if doc.Channel.Items[0].Enclosure == nil || doc.Channel.Items[0].Enclosure.Type != "image/png" {
+		t.Errorf("enclosure = %+v, want image/png", doc.Channel.Items[0].Enclosure)
+	}
+	if doc.Channel.Items[1].Enclosure != nil {
+		t.Error("item without image should have no enclosure")
+	}
+	if !strings.Contains(string(body), `<atom:link rel="self"`) {
+		t.Error("RSS() missing atom:link self reference")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	body, err := testFeed().JSON()
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+
+	var doc jsonFeed
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("JSON() produced invalid JSON: %v", err)
+	}
+	if doc.Version != jsonFeedVersion || len(doc.Items) != 2 {
+		t.Fatalf("JSON() = %+v, want version 1.1 with 2 items", doc)
+	}
+	if doc.Items[0].Image != "http://files/images/1.png" || doc.Items[0].DateModified != "2024-03-03T10:00:00Z" {
+		t.Errorf("item = %+v, want absolute image and modified date", doc.Items[0])
+	}
+}
+
+func TestSortNewest(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := []Item{
+		{Title: "old", Published: base},
+		{Title: "new", Published: base.Add(2 * time.Hour)},
+		{Title: "mid", Published: base.Add(time.Hour)},
+	}
+
+	got := SortNewest(items, 2)
+
+	if len(got) != 2 || got[0].Title != "new" || got[1].Title != "mid" {
+		t.Errorf("SortNewest() = %+v, want new, mid", got)
+	}
+}
+
+func TestUpdated_EmptyFeed(t *testing.T) {
+	if got := (Feed{}).Updated(); !got.Equal(time.Unix(0, 0)) {
+		t.Errorf("Updated() = %v, want Unix epoch", got)
+	}
+}
@@ -0,0 +1,83 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type rssDocument struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	AtomXmlns string     `xml:"xmlns:atom,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string   `xml:"title"`
+	Link          string   `xml:"link"`
+	Description   string   `xml:"description"`
+	AtomLink      atomLink `xml:"atom:link"`
+	LastBuildDate string   `xml:"lastBuildDate"`
+	Items         []rssItem
+}
+
+type rssItem struct {
+	XMLName     xml.Name      `xml:"item"`
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Description string        `xml:"description,omitempty"`
+	Categories  []string      `xml:"category"`
+	Enclosure   *rssEnclosure `xml:"enclosure"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// RSS renders the feed as an RSS 2.0 document with an atom:link self reference
+func (f Feed) RSS() ([]byte, error) {
+	description := f.Description
+	if description == "" {
+		// RSS requires a channel description
+		description = f.Title
+	}
+
+	doc := rssDocument{
+		Version:   "2.0",
+		AtomXmlns: atomNamespace,
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Description:   description,
+			AtomLink:      atomLink{Rel: "self", Href: f.FeedURL, Type: "application/rss+xml"},
+			LastBuildDate: f.Updated().Format(time.RFC1123Z),
+		},
+	}
+
+	for _, item := range f.Items {
+		entry := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.Link},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Description: item.Summary,
+			Categories:  item.Categories,
+		}
+		if item.Image != nil && item.Image.MimeType != "" {
+			// An RSS enclosure requires a type, and its length is mandatory (0 when unknown)
+			entry.Enclosure = &rssEnclosure{URL: item.Image.URL, Length: item.Image.Length, Type: item.Image.MimeType}
+		}
+		doc.Channel.Items = append(doc.Channel.Items, entry)
+	}
+
+	return marshalXML(doc)
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/portfolio-common/logger"
+	"github.com/GunarsK-portfolio/public-api/internal/archive"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/slug"
+	"github.com/gin-gonic/gin"
+)
+
+// GetMiniatureThemeArchive godoc
+// @Summary Download a miniature theme gallery
+// @Description Stream a ZIP of the photos of every miniature in a theme, one folder per miniature in display
+// @Description order, followed by manifest.json describing each miniature with its paints, techniques and
+// @Description photos. Photos are full-size JPEGs without EXIF metadata. A photo that cannot be fetched is
+// @Description left out and listed in the manifest without a file.
+// @Tags miniatures
+// @Produce application/zip
+// @Param id path int true "Miniature Theme ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/themes/{id}/archive.zip [get]
+func (h *Handler) GetMiniatureThemeArchive(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	theme, err := h.repo.GetMiniatureThemeByID(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to fetch miniature theme")
+		return
+	}
+	manifest := archive.Manifest{Title: theme.Name, Description: theme.Description}
+	h.streamArchive(c, archiveFileName(theme.Name, "theme", theme.ID), manifest, theme.Miniatures, true)
+}
+
+// GetMiniatureArchive godoc
+// @Summary Download a miniature gallery
+// @Description Stream a ZIP of the photos of a miniature in display order, followed by manifest.json
+// @Description describing the miniature with its paints, techniques and photos. Photos are full-size JPEGs
+// @Description without EXIF metadata. A photo that cannot be fetched is left out and listed in the manifest
+// @Description without a file.
+// @Tags miniatures
+// @Produce application/zip
+// @Param id path int true "Miniature Project ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/projects/{id}/archive.zip [get]
+func (h *Handler) GetMiniatureArchive(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	project, err := h.repo.GetMiniatureProjectByID(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature project")
+		return
+	}
+	manifest := archive.Manifest{Title: project.Title, Description: project.Description}
+	h.streamArchive(c, archiveFileName(project.Title, "miniature", project.ID), manifest,
+		[]models.MiniatureProject{*project}, false)
+}
+
+// archiveEntryTimeout is how long each archive entry may take to render and
+// send. The server write timeout covers a whole response, which a large
+// gallery would exceed, so the deadline is moved forward before every entry.
+const archiveEntryTimeout = 30 * time.Second
+
+// streamArchive writes the photos of miniatures as a ZIP, one at a time so
+// memory stays bounded by the largest photo. Once the first byte is sent the
+// status can no longer change, so failed photos are logged and skipped, and
+// a cancelled request ends the stream without the ZIP directory.
+func (h *Handler) streamArchive(c *gin.Context, fileName string, manifest archive.Manifest, miniatures []models.MiniatureProject, folders bool) {
+	ctx := c.Request.Context()
+	log := logger.GetLogger(c)
+	rc := http.NewResponseController(c.Writer)
+	extendDeadline := func() {
+		// Writers without deadlines, such as test recorders, are not limited by one
+		if err := rc.SetWriteDeadline(time.Now().Add(archiveEntryTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			log.Warn("Failed to extend archive write deadline", "file", fileName, "error", err)
+		}
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fileName))
+	c.Status(http.StatusOK)
+
+	zw := archive.NewWriter(c.Writer)
+	manifest.Miniatures = make([]archive.Miniature, 0, len(miniatures))
+	for i := range miniatures {
+		miniature := &miniatures[i]
+		entry := archiveMiniature(miniature)
+		prefix := ""
+		if folders {
+			prefix = archive.Name(i+1, miniature.Title, "miniature") + "/"
+		}
+
+		for _, file := range miniature.MiniatureFiles {
+			if file.File == nil || !strings.HasPrefix(file.File.MimeType, "image/") {
+				continue
+			}
+			if ctx.Err() != nil {
+				log.Info("Archive download cancelled", "file", fileName)
+				return
+			}
+			extendDeadline()
+
+			photo := archive.Photo{
+				Caption:    file.Caption,
+				Width:      file.File.Width,
+				Height:     file.File.Height,
+				CapturedAt: file.File.CapturedAt,
+			}
+			name := prefix + archive.Name(len(entry.Photos)+1, file.Caption, "photo") + ".jpg"
+			data, err := h.renderImage(ctx, file.File, imaging.Params{Fit: imaging.FitInside}, imaging.FormatJPEG)
+			if err != nil {
+				log.Warn("Archive photo unavailable, leaving it out",
+					"miniature_id", miniature.ID, "file_id", file.File.ID, "error", err)
+				entry.Photos = append(entry.Photos, photo)
+				continue
+			}
+			if err := zw.AddPhoto(name, data, file.File.CreatedAt); err != nil {
+				log.Warn("Archive download aborted", "file", fileName, "error", err)
+				return
+			}
+			photo.File = name
+			entry.Photos = append(entry.Photos, photo)
+			c.Writer.Flush()
+		}
+		manifest.Miniatures = append(manifest.Miniatures, entry)
+	}
+
+	extendDeadline()
+	manifest.GeneratedAt = time.Now().UTC()
+	if err := zw.Close(manifest); err != nil {
+		log.Warn("Archive download aborted", "file", fileName, "error", err)
+	}
+}
+
+// archiveMiniature describes a miniature for the manifest, without photos
+func archiveMiniature(miniature *models.MiniatureProject) archive.Miniature {
+	entry := archive.Miniature{
+		ID:           miniature.ID,
+		Name:         miniature.Title,
+		Description:  miniature.Description,
+		Scale:        miniature.Scale,
+		Manufacturer: miniature.Manufacturer,
+		Paints:       make([]archive.Paint, 0, len(miniature.Paints)),
+		Techniques:   make([]archive.Technique, 0, len(miniature.Techniques)),
+		Photos:       make([]archive.Photo, 0, len(miniature.MiniatureFiles)),
+	}
+	if miniature.CompletedDate != nil {
+		entry.CompletedDate = *miniature.CompletedDate
+	}
+	for _, used := range miniature.Paints {
+		if used.Paint == nil {
+			continue
+		}
+		paint := archive.Paint{Name: used.Paint.Name, Manufacturer: used.Paint.Manufacturer, Notes: used.Notes}
+		if used.Paint.ColorHex != nil {
+			paint.ColorHex = *used.Paint.ColorHex
+		}
+		if used.Paint.PaintType != nil {
+			paint.PaintType = *used.Paint.PaintType
+		}
+		entry.Paints = append(entry.Paints, paint)
+	}
+	for _, used := range miniature.Techniques {
+		if used.Technique != nil {
+			entry.Techniques = append(entry.Techniques, archive.Technique{Name: used.Technique.Name, Notes: used.Notes})
+		}
+	}
+	return entry
+}
+
+// archiveFileName names the download after its title, falling back to the
+// kind and ID for titles with no ASCII letters or digits
+func archiveFileName(title, kind string, id int64) string {
+	name := slug.Make(title)
+	if name == "" {
+		name = fmt.Sprintf("%s-%d", kind, id)
+	}
+	return name + ".zip"
+}
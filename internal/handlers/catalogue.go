@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"cmp"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/colour"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSimilarLimit = 10
+	maxSimilarLimit     = 50
+)
+
+// GetPaints godoc
+// @Summary Get all paints
+// @Description Get the paint catalogue ordered by manufacturer and name, with the number of miniatures using each paint
+// @Tags miniatures
+// @Produce json
+// @Success 200 {array} models.PaintWithUsage
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/paints [get]
+func (h *Handler) GetPaints(c *gin.Context) {
+	paints, err := h.repo.GetAllPaints(c.Request.Context())
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch paints")
+		return
+	}
+	c.JSON(http.StatusOK, paints)
+}
+
+// GetPaintMiniatures godoc
+// @Summary Get miniatures painted with a paint
+// @Description Get a paint with its usage count and every miniature project it was used on
+// @Tags miniatures
+// @Produce json
+// @Param id path int true "Paint ID"
+// @Success 200 {object} models.PaintMiniatures
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/paints/{id} [get]
+func (h *Handler) GetPaintMiniatures(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	paint, err := h.repo.GetPaintMiniatures(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "paint not found", "failed to fetch paint miniatures")
+		return
+	}
+	c.JSON(http.StatusOK, paint)
+}
+
+// GetSimilarPaints godoc
+// @Summary Find paints with a similar colour
+// @Description Rank catalogue paints of every manufacturer by CIEDE2000 colour difference (ΔE2000) from hex.
+// @Description Paints without a valid colour are skipped. Below ΔE 2 two colours are hard to tell apart;
+// @Description above 10 they read as clearly different. With miniatures=true the miniatures painted with
+// @Description any of the returned paints are included.
+// @Tags miniatures
+// @Produce json
+// @Param hex query string true "Colour as #RRGGBB or #RGB; the # is optional and must be URL-encoded as %23"
+// @Param limit query int false "Maximum number of paints (default 10, max 50)"
+// @Param maxDeltaE query number false "Only return paints within this ΔE2000 distance"
+// @Param miniatures query bool false "Include miniatures that use any of the returned paints"
+// @Success 200 {object} models.SimilarPaints
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/paints/similar [get]
+func (h *Handler) GetSimilarPaints(c *gin.Context) {
+	target, err := colour.ParseHex(c.Query("hex"))
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid hex")
+		return
+	}
+
+	limit := defaultSimilarLimit
+	if raw, ok := c.GetQuery("limit"); ok {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxSimilarLimit {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+
+	maxDeltaE := math.Inf(1)
+	if raw, ok := c.GetQuery("maxDeltaE"); ok {
+		maxDeltaE, err = strconv.ParseFloat(raw, 64)
+		if err != nil || !(maxDeltaE > 0) || math.IsInf(maxDeltaE, 0) {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid maxDeltaE")
+			return
+		}
+	}
+
+	withMiniatures, err := parseOptionalBool(c, "miniatures")
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	paints, err := h.repo.GetAllPaints(c.Request.Context())
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch paints")
+		return
+	}
+
+	result := models.SimilarPaints{
+		Hex:    target.Hex(),
+		Paints: rankPaints(paints, target.Lab(), limit, maxDeltaE),
+	}
+
+	if withMiniatures != nil && *withMiniatures {
+		miniatures := []models.MiniatureProject{}
+		if len(result.Paints) > 0 {
+			ids := make([]int64, len(result.Paints))
+			for i, paint := range result.Paints {
+				ids[i] = paint.ID
+			}
+			painted, _, err := h.repo.ListMiniatureProjects(c.Request.Context(), repository.MiniatureFilter{PaintIDs: ids})
+			if err != nil {
+				commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature projects")
+				return
+			}
+			miniatures = append(miniatures, painted...)
+		}
+		result.Miniatures = &miniatures
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// rankPaints returns up to limit paints within maxDeltaE of target, nearest first.
+// Equal distances keep catalogue order.
+func rankPaints(paints []models.PaintWithUsage, target colour.Lab, limit int, maxDeltaE float64) []models.SimilarPaint {
+	ranked := []models.SimilarPaint{}
+	for _, paint := range paints {
+		if paint.ColorHex == nil {
+			continue
+		}
+		rgb, err := colour.ParseHex(*paint.ColorHex)
+		if err != nil {
+			continue
+		}
+		distance := colour.DeltaE2000(target, rgb.Lab())
+		if distance > maxDeltaE {
+			continue
+		}
+		// Two decimals are well below the threshold of a visible difference
+		ranked = append(ranked, models.SimilarPaint{PaintWithUsage: paint, DeltaE: math.Round(distance*100) / 100})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b models.SimilarPaint) int {
+		return cmp.Compare(a.DeltaE, b.DeltaE)
+	})
+	return ranked[:min(limit, len(ranked))]
+}
+
+// GetTechniques godoc
+// @Summary Get all techniques
+// @Description Get the painting technique catalogue in display order, with the number of miniatures using each technique
+// @Tags miniatures
+// @Produce json
+// @Success 200 {array} models.TechniqueWithUsage
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/techniques [get]
+func (h *Handler) GetTechniques(c *gin.Context) {
+	techniques, err := h.repo.GetAllTechniques(c.Request.Context())
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch techniques")
+		return
+	}
+	c.JSON(http.StatusOK, techniques)
+}
+
+// GetTechniqueMiniatures godoc
+// @Summary Get miniatures using a technique
+// @Description Get a painting technique with its usage count and every miniature project it was used on
+// @Tags miniatures
+// @Produce json
+// @Param id path int true "Technique ID"
+// @Success 200 {object} models.TechniqueMiniatures
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/techniques/{id} [get]
+func (h *Handler) GetTechniqueMiniatures(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	technique, err := h.repo.GetTechniqueMiniatures(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "technique not found", "failed to fetch technique miniatures")
+		return
+	}
+	c.JSON(http.StatusOK, technique)
+}
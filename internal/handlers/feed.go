@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/feed"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 50
+)
+
+// GetFeedAtom godoc
+// @Summary Atom feed of recent content
+// @Description Get the newest projects and miniatures as an Atom 1.0 feed
+// @Tags feeds
+// @Produce application/atom+xml
+// @Param limit query int false "Maximum number of entries (default 20, max 50)"
+// @Success 200 {string} string "Atom document"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /feed.atom [get]
+func (h *Handler) GetFeedAtom(c *gin.Context) {
+	h.serveFeed(c, "/feed.atom", feed.AtomContentType, feed.Feed.Atom)
+}
+
+// GetFeedRSS godoc
+// @Summary RSS feed of recent content
+// @Description Get the newest projects and miniatures as an RSS 2.0 feed
+// @Tags feeds
+// @Produce application/rss+xml
+// @Param limit query int false "Maximum number of entries (default 20, max 50)"
+// @Success 200 {string} string "RSS document"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /feed.rss [get]
+func (h *Handler) GetFeedRSS(c *gin.Context) {
+	h.serveFeed(c, "/feed.rss", feed.RSSContentType, feed.Feed.RSS)
+}
+
+// GetFeedJSON godoc
+// @Summary JSON Feed of recent content
+// @Description Get the newest projects and miniatures as a JSON Feed 1.1 document
+// @Tags feeds
+// @Produce application/feed+json
+// @Param limit query int false "Maximum number of entries (default 20, max 50)"
+// @Success 200 {string} string "JSON Feed document"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /feed.json [get]
+func (h *Handler) GetFeedJSON(c *gin.Context) {
+	h.serveFeed(c, "/feed.json", feed.JSONContentType, feed.Feed.JSON)
+}
+
+// serveFeed loads the newest content and writes it with the given encoder.
+// Entry links point at the website, so feeds need a configured site URL.
+func (h *Handler) serveFeed(c *gin.Context, path, contentType string, encode func(feed.Feed) ([]byte, error)) {
+	if !h.requireSiteURL(c) {
+		return
+	}
+	limit := defaultFeedLimit
+	if raw, ok := c.GetQuery("limit"); ok {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxFeedLimit {
+			commonHandlers.RespondError(c, http.StatusBadRequest, errInvalidLimit.Error())
+			return
+		}
+		limit = parsed
+	}
+
+	content, err := h.buildFeed(c.Request.Context(), h.siteURL, h.apiURL+path, limit)
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to build feed")
+		return
+	}
+
+	body, err := encode(content)
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to build feed")
+		return
+	}
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// buildFeed merges the newest projects and miniatures into one feed, newest first
+func (h *Handler) buildFeed(ctx context.Context, siteURL, feedURL string, limit int) (feed.Feed, error) {
+	var (
+		profile    *models.Profile
+		projects   []models.PortfolioProject
+		miniatures []models.MiniatureProject
+	)
+	newest := repository.Pagination{Page: 1, Limit: limit}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		profile, err = h.repo.GetProfile(ctx)
+		// The profile only names the feed, so a missing one is not an error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	})
+	g.Go(func() (err error) {
+		projects, _, err = h.repo.ListProjects(ctx, repository.ProjectFilter{Sort: "-createdAt", Pagination: newest})
+		return err
+	})
+	g.Go(func() (err error) {
+		miniatures, _, err = h.repo.ListMiniatureProjects(ctx, repository.MiniatureFilter{Sort: "-createdAt", Pagination: newest})
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return feed.Feed{}, err
+	}
+
+	content := feed.Feed{
+		Title:       "Portfolio",
+		Description: "Recently added projects and miniatures",
+		Link:        siteURL,
+		FeedURL:     feedURL,
+	}
+	if profile != nil {
+		content.Title = profile.FullName + " - Portfolio"
+		content.Author = profile.FullName
+	}
+
+	items := make([]feed.Item, 0, len(projects)+len(miniatures))
+	for _, project := range projects {
+		item := feed.Item{
+			Title:      project.Title,
+			Summary:    project.Description,
+			Link:       siteURL + fmt.Sprintf(projectPagePath, project.ID),
+			Categories: []string{models.SearchTypeProject},
+			Image:      enclosure(project.ImageFile),
+			Published:  project.CreatedAt,
+			Updated:    project.UpdatedAt,
+		}
+		if project.Category != "" {
+			item.Categories = append(item.Categories, project.Category)
+		}
+		for _, technology := range project.Technologies {
+			item.Categories = append(item.Categories, technology.Skill)
+		}
+		items = append(items, item)
+	}
+	for _, miniature := range miniatures {
+		item := feed.Item{
+			Title:      miniature.Title,
+			Summary:    miniature.Description,
+			Link:       siteURL + fmt.Sprintf(miniaturePagePath, miniature.ID),
+			Categories: []string{models.SearchTypeMiniature},
+			Published:  miniature.CreatedAt,
+			Updated:    miniature.UpdatedAt,
+		}
+		if len(miniature.Images) > 0 {
+			item.Image = imageEnclosure(miniature.Images[0].URL)
+		}
+		items = append(items, item)
+	}
+	content.Items = feed.SortNewest(items, limit)
+
+	return content, nil
+}
+
+// enclosure describes a stored file whose URL was populated by the repository
+func enclosure(file *models.StorageFile) *feed.Enclosure {
+	if file == nil || file.URL == "" {
+		return nil
+	}
+	if strings.HasPrefix(file.MimeType, "image/") {
+		return imageEnclosure(file.URL)
+	}
+	return &feed.Enclosure{URL: file.URL, MimeType: file.MimeType, Length: file.FileSize}
+}
+
+// imageEnclosure describes a published image by its JPEG rendition, so the
+// type holds whatever the reader accepts. The original's size says nothing
+// about the rendition's, which is only known once rendered, so none is given.
+func imageEnclosure(imageURL string) *feed.Enclosure {
+	return &feed.Enclosure{URL: imageURL + "?fmt=" + imaging.FormatJPEG, MimeType: "image/" + imaging.FormatJPEG}
+}
@@ -1,11 +1,74 @@
 package handlers
 
-import "github.com/GunarsK-portfolio/public-api/internal/repository"
+import (
+	"net/http"
+
+	"github.com/GunarsK-portfolio/public-api/internal/cache"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/GunarsK-portfolio/public-api/internal/resume"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
+)
 
 type Handler struct {
-	repo repository.Repository
+	repo        repository.Repository
+	resumePDF   *resume.PDFCache
+	ogImages    cache.Store
+	images      cache.Store
+	renders     singleflight.Group
+	renderSlots *semaphore.Weighted
+	imageClient *http.Client
+	siteURL     string
+	apiURL      string
+
+	imageSigningKey []byte
+}
+
+// Option configures optional Handler dependencies
+type Option func(*Handler)
+
+// WithSiteURL sets the public website origin used for absolute links
+func WithSiteURL(siteURL string) Option {
+	return func(h *Handler) {
+		h.siteURL = siteURL
+	}
+}
+
+// WithAPIURL sets the public base URL of this API, including /api/v1, used for
+// absolute links to its own routes
+func WithAPIURL(apiURL string) Option {
+	return func(h *Handler) {
+		h.apiURL = apiURL
+	}
+}
+
+// WithImageSigningKey allows arbitrary image renditions whose parameters are
+// signed with key (see imaging.Sign). Without a key only the variant widths are served.
+func WithImageSigningKey(key string) Option {
+	return func(h *Handler) {
+		h.imageSigningKey = []byte(key)
+	}
+}
+
+// WithImageCache stores rendered images in store instead of the default
+// in-process LRU cache
+func WithImageCache(store cache.Store) Option {
+	return func(h *Handler) {
+		h.images = store
+	}
 }
 
-func New(repo repository.Repository) *Handler {
-	return &Handler{repo: repo}
+func New(repo repository.Repository, opts ...Option) *Handler {
+	h := &Handler{
+		repo:        repo,
+		resumePDF:   &resume.PDFCache{},
+		ogImages:    cache.NewMemoryStore(ogImageCacheEntries, nil),
+		images:      cache.NewMemoryStore(imageCacheEntries, nil),
+		renderSlots: semaphore.NewWeighted(maxConcurrentRenders),
+		imageClient: &http.Client{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
@@ -1,16 +1,31 @@
 package handlers
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	commonModels "github.com/GunarsK-portfolio/portfolio-common/models"
+	"github.com/GunarsK-portfolio/public-api/internal/archive"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/GunarsK-portfolio/public-api/internal/resume"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
@@ -41,12 +56,31 @@ type mockRepository struct {
 	getAllWorkExperienceFunc    func(ctx context.Context) ([]models.WorkExperience, error)
 	getAllCertificationsFunc    func(ctx context.Context) ([]models.Certification, error)
 	getAllSkillsFunc            func(ctx context.Context) ([]models.Skill, error)
+	getSkillGroupsFunc          func(ctx context.Context) ([]models.SkillGroup, error)
+	getSkillProjectsFunc        func(ctx context.Context, id int64) (*models.SkillProjects, error)
 	getAllProjectsFunc          func(ctx context.Context) ([]models.PortfolioProject, error)
+	listProjectsFunc            func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error)
 	getProjectByIDFunc          func(ctx context.Context, id int64) (*models.PortfolioProject, error)
 	getAllMiniatureProjectsFunc func(ctx context.Context) ([]models.MiniatureProject, error)
+	listMiniatureProjectsFunc   func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error)
 	getMiniatureProjectByIDFunc func(ctx context.Context, id int64) (*models.MiniatureProject, error)
 	getAllMiniatureThemesFunc   func(ctx context.Context) ([]models.MiniatureTheme, error)
 	getMiniatureThemeByIDFunc   func(ctx context.Context, id int64) (*models.MiniatureTheme, error)
+	getProjectSlugsFunc         func(ctx context.Context) (map[int64]string, error)
+	getMiniatureSlugsFunc       func(ctx context.Context) (map[int64]string, error)
+	getThemeSlugsFunc           func(ctx context.Context) (map[int64]string, error)
+	getProjectBySlugFunc        func(ctx context.Context, slug string) (*models.PortfolioProject, error)
+	getMiniatureBySlugFunc      func(ctx context.Context, slug string) (*models.MiniatureProject, error)
+	getThemeBySlugFunc          func(ctx context.Context, slug string) (*models.MiniatureTheme, error)
+	getAllPaintsFunc            func(ctx context.Context) ([]models.PaintWithUsage, error)
+	getPaintMiniaturesFunc      func(ctx context.Context, id int64) (*models.PaintMiniatures, error)
+	getAllTechniquesFunc        func(ctx context.Context) ([]models.TechniqueWithUsage, error)
+	getTechniqueMiniaturesFunc  func(ctx context.Context, id int64) (*models.TechniqueMiniatures, error)
+	getImageFileFunc            func(ctx context.Context, id int64) (*models.StorageFile, error)
+	getSitemapPagesFunc         func(ctx context.Context) (*models.SitemapPages, error)
+	getProjectNavigationFunc    func(ctx context.Context, id int64, filter repository.ProjectFilter, relatedLimit int) (*models.Navigation, error)
+	getMiniatureNavigationFunc  func(ctx context.Context, id int64, filter repository.MiniatureFilter, relatedLimit int) (*models.Navigation, error)
+	searchFunc                  func(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error)
 }
 
 func (m *mockRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
@@ -77,6 +111,20 @@ func (m *mockRepository) GetAllSkills(ctx context.Context) ([]models.Skill, erro
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockRepository) GetSkillGroups(ctx context.Context) ([]models.SkillGroup, error) {
+	if m.getSkillGroupsFunc != nil {
+		return m.getSkillGroupsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetSkillProjects(ctx context.Context, id int64) (*models.SkillProjects, error) {
+	if m.getSkillProjectsFunc != nil {
+		return m.getSkillProjectsFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockRepository) GetAllProjects(ctx context.Context) ([]models.PortfolioProject, error) {
 	if m.getAllProjectsFunc != nil {
 		return m.getAllProjectsFunc(ctx)
@@ -84,6 +132,13 @@ func (m *mockRepository) GetAllProjects(ctx context.Context) ([]models.Portfolio
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockRepository) ListProjects(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+	if m.listProjectsFunc != nil {
+		return m.listProjectsFunc(ctx, filter)
+	}
+	return nil, 0, errors.New("not implemented")
+}
+
 func (m *mockRepository) GetProjectByID(ctx context.Context, id int64) (*models.PortfolioProject, error) {
 	if m.getProjectByIDFunc != nil {
 		return m.getProjectByIDFunc(ctx, id)
@@ -98,6 +153,13 @@ func (m *mockRepository) GetAllMiniatureProjects(ctx context.Context) ([]models.
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockRepository) ListMiniatureProjects(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+	if m.listMiniatureProjectsFunc != nil {
+		return m.listMiniatureProjectsFunc(ctx, filter)
+	}
+	return nil, 0, errors.New("not implemented")
+}
+
 func (m *mockRepository) GetMiniatureProjectByID(ctx context.Context, id int64) (*models.MiniatureProject, error) {
 	if m.getMiniatureProjectByIDFunc != nil {
 		return m.getMiniatureProjectByIDFunc(ctx, id)
@@ -119,6 +181,111 @@ func (m *mockRepository) GetMiniatureThemeByID(ctx context.Context, id int64) (*
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockRepository) GetSitemapPages(ctx context.Context) (*models.SitemapPages, error) {
+	if m.getSitemapPagesFunc != nil {
+		return m.getSitemapPagesFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetProjectSlugs(ctx context.Context) (map[int64]string, error) {
+	if m.getProjectSlugsFunc != nil {
+		return m.getProjectSlugsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetMiniatureSlugs(ctx context.Context) (map[int64]string, error) {
+	if m.getMiniatureSlugsFunc != nil {
+		return m.getMiniatureSlugsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetThemeSlugs(ctx context.Context) (map[int64]string, error) {
+	if m.getThemeSlugsFunc != nil {
+		return m.getThemeSlugsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetProjectBySlug(ctx context.Context, slug string) (*models.PortfolioProject, error) {
+	if m.getProjectBySlugFunc != nil {
+		return m.getProjectBySlugFunc(ctx, slug)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetMiniatureProjectBySlug(ctx context.Context, slug string) (*models.MiniatureProject, error) {
+	if m.getMiniatureBySlugFunc != nil {
+		return m.getMiniatureBySlugFunc(ctx, slug)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetMiniatureThemeBySlug(ctx context.Context, slug string) (*models.MiniatureTheme, error) {
+	if m.getThemeBySlugFunc != nil {
+		return m.getThemeBySlugFunc(ctx, slug)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetAllPaints(ctx context.Context) ([]models.PaintWithUsage, error) {
+	if m.getAllPaintsFunc != nil {
+		return m.getAllPaintsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetPaintMiniatures(ctx context.Context, id int64) (*models.PaintMiniatures, error) {
+	if m.getPaintMiniaturesFunc != nil {
+		return m.getPaintMiniaturesFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetAllTechniques(ctx context.Context) ([]models.TechniqueWithUsage, error) {
+	if m.getAllTechniquesFunc != nil {
+		return m.getAllTechniquesFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetTechniqueMiniatures(ctx context.Context, id int64) (*models.TechniqueMiniatures, error) {
+	if m.getTechniqueMiniaturesFunc != nil {
+		return m.getTechniqueMiniaturesFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetImageFile(ctx context.Context, id int64) (*models.StorageFile, error) {
+	if m.getImageFileFunc != nil {
+		return m.getImageFileFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetProjectNavigation(ctx context.Context, id int64, filter repository.ProjectFilter, relatedLimit int) (*models.Navigation, error) {
+	if m.getProjectNavigationFunc != nil {
+		return m.getProjectNavigationFunc(ctx, id, filter, relatedLimit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) GetMiniatureNavigation(ctx context.Context, id int64, filter repository.MiniatureFilter, relatedLimit int) (*models.Navigation, error) {
+	if m.getMiniatureNavigationFunc != nil {
+		return m.getMiniatureNavigationFunc(ctx, id, filter, relatedLimit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockRepository) Search(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(ctx, query, types, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
 // =============================================================================
 // Test Helpers
 // =============================================================================
@@ -140,13 +307,15 @@ func setupTestRouter(t *testing.T) *gin.Engine {
 
 func createTestProfile() models.Profile {
 	return models.Profile{
-		ID:        1,
-		FullName:  testProfileName,
-		Title:     testProfileTitle,
-		Bio:       "Experienced software engineer",
-		Email:     "john@example.com",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Profile: commonModels.Profile{
+			ID:        1,
+			FullName:  testProfileName,
+			Title:     testProfileTitle,
+			Bio:       "Experienced software engineer",
+			Email:     "john@example.com",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
 	}
 }
 
@@ -188,29 +357,35 @@ func createTestWorkExperience() models.WorkExperience {
 
 func createTestProject() models.PortfolioProject {
 	return models.PortfolioProject{
-		ID:          1,
-		Title:       testProjectName,
-		Description: "A portfolio website built with Vue and Go",
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		PortfolioProject: commonModels.PortfolioProject{
+			ID:          1,
+			Title:       testProjectName,
+			Description: "A portfolio website built with Vue and Go",
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		},
 	}
 }
 
 func createTestMiniatureProject() models.MiniatureProject {
 	return models.MiniatureProject{
-		ID:        1,
-		Title:     testMiniatureName,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		MiniatureProject: commonModels.MiniatureProject{
+			ID:        1,
+			Title:     testMiniatureName,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
 	}
 }
 
 func createTestMiniatureTheme() models.MiniatureTheme {
 	return models.MiniatureTheme{
-		ID:        1,
-		Name:      testMiniatureTheme,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		MiniatureTheme: commonModels.MiniatureTheme{
+			ID:        1,
+			Name:      testMiniatureTheme,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
 	}
 }
 
@@ -312,6 +487,105 @@ func TestGetProfile_RepositoryError(t *testing.T) {
 	}
 }
 
+func TestGetProfileVCard_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithSiteURL("https://example.com"))
+	router := setupTestRouter(t)
+	router.GET("/profile.vcf", handler.GetProfileVCard)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		profile := createTestProfile()
+		return &profile, nil
+	}
+
+	w := performRequest(t, router, "GET", "/profile.vcf", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetProfileVCard() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/vcard; charset=utf-8" {
+		t.Errorf("GetProfileVCard() Content-Type = %s, want text/vcard", got)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{"BEGIN:VCARD\r\n", "FN:" + testProfileName + "\r\n", "URL;PREF=1:https://example.com\r\n"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("GetProfileVCard() body missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestGetProfileVCard_NotFound(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/profile.vcf", handler.GetProfileVCard)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	w := performRequest(t, router, "GET", "/profile.vcf", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetProfileVCard() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetProfileQR_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/profile/qr.png", handler.GetProfileQR)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		profile := createTestProfile()
+		return &profile, nil
+	}
+
+	w := performRequest(t, router, "GET", "/profile/qr.png?size=256", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetProfileQR() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "image/png" {
+		t.Errorf("GetProfileQR() Content-Type = %s, want image/png", got)
+	}
+
+	config, err := png.DecodeConfig(w.Body)
+	if err != nil {
+		t.Fatalf("GetProfileQR() returned invalid PNG: %v", err)
+	}
+	if config.Width != 256 || config.Height != 256 {
+		t.Errorf("GetProfileQR() size = %dx%d, want 256x256", config.Width, config.Height)
+	}
+}
+
+func TestGetProfileQR_InvalidParams(t *testing.T) {
+	handler, _ := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/profile/qr.png", handler.GetProfileQR)
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"size too small", "/profile/qr.png?size=64", http.StatusBadRequest},
+		{"size not a number", "/profile/qr.png?size=big", http.StatusBadRequest},
+		{"unknown content", "/profile/qr.png?content=email", http.StatusBadRequest},
+		{"url without site url", "/profile/qr.png?content=url", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performRequest(t, router, "GET", tt.path, nil)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Work Experience Handler Tests
 // =============================================================================
@@ -482,6 +756,112 @@ func TestGetSkills_RepositoryError(t *testing.T) {
 	}
 }
 
+func TestGetSkills_GroupByType(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/skills", handler.GetSkills)
+
+	mockRepo.getAllSkillsFunc = func(ctx context.Context) ([]models.Skill, error) {
+		t.Error("GetAllSkills should not be called when grouping")
+		return nil, nil
+	}
+	mockRepo.getSkillGroupsFunc = func(ctx context.Context) ([]models.SkillGroup, error) {
+		return []models.SkillGroup{
+			{
+				SkillType: models.SkillType{ID: 1, Name: "Languages"},
+				Skills:    []models.SkillWithUsage{{Skill: createTestSkill(), ProjectCount: 3}},
+			},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/skills?group=type", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetSkills() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result []struct {
+		ID     int64  `json:"id"`
+		Name   string `json:"name"`
+		Skills []struct {
+			Skill        string `json:"skill"`
+			ProjectCount int64  `json:"projectCount"`
+		} `json:"skills"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if len(result) != 1 || result[0].Name != "Languages" || len(result[0].Skills) != 1 {
+		t.Fatalf("GetSkills() groups = %+v, want one Languages group with one skill", result)
+	}
+	if result[0].Skills[0].Skill != testSkillName || result[0].Skills[0].ProjectCount != 3 {
+		t.Errorf("GetSkills() skill = %+v, want %s used by 3 projects", result[0].Skills[0], testSkillName)
+	}
+}
+
+func TestGetSkills_InvalidGroup(t *testing.T) {
+	handler, _ := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/skills", handler.GetSkills)
+
+	w := performRequest(t, router, "GET", "/skills?group=category", nil)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("GetSkills() status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetSkillProjects_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/skills/:id/projects", handler.GetSkillProjects)
+
+	mockRepo.getSkillProjectsFunc = func(ctx context.Context, id int64) (*models.SkillProjects, error) {
+		if id != 1 {
+			t.Errorf("GetSkillProjects() id = %d, want 1", id)
+		}
+		return &models.SkillProjects{
+			Skill:    createTestSkill(),
+			Projects: []models.PortfolioProject{createTestProject()},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/skills/1/projects", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetSkillProjects() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result models.SkillProjects
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if result.Skill.Skill != testSkillName {
+		t.Errorf("GetSkillProjects() skill = %s, want %s", result.Skill.Skill, testSkillName)
+	}
+	if len(result.Projects) != 1 {
+		t.Errorf("GetSkillProjects() returned %d projects, want 1", len(result.Projects))
+	}
+}
+
+func TestGetSkillProjects_NotFound(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/skills/:id/projects", handler.GetSkillProjects)
+
+	mockRepo.getSkillProjectsFunc = func(ctx context.Context, id int64) (*models.SkillProjects, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	w := performRequest(t, router, "GET", "/skills/999/projects", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetSkillProjects() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
 // =============================================================================
 // Projects Handler Tests
 // =============================================================================
@@ -492,8 +872,10 @@ func TestGetProjects_Success(t *testing.T) {
 	router.GET("/projects", handler.GetProjects)
 
 	expectedProjects := []models.PortfolioProject{createTestProject()}
-	mockRepo.getAllProjectsFunc = func(ctx context.Context) ([]models.PortfolioProject, error) {
-		return expectedProjects, nil
+	var receivedFilter repository.ProjectFilter
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		receivedFilter = filter
+		return expectedProjects, int64(len(expectedProjects)), nil
 	}
 
 	w := performRequest(t, router, "GET", "/projects", nil)
@@ -502,13 +884,21 @@ func TestGetProjects_Success(t *testing.T) {
 		t.Errorf("GetProjects() status = %d, want %d", w.Code, http.StatusOK)
 	}
 
-	var result []models.PortfolioProject
+	// Without page or limit the first page is returned in the same envelope
+	if receivedFilter.Page != 1 || receivedFilter.Limit != 20 {
+		t.Errorf("GetProjects() pagination = %+v, want page 1 limit 20", receivedFilter.Pagination)
+	}
+
+	var result models.PaginatedResponse[models.PortfolioProject]
 	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(result) != 1 {
-		t.Errorf("GetProjects() returned %d items, want 1", len(result))
+	if len(result.Data) != 1 {
+		t.Errorf("GetProjects() returned %d items, want 1", len(result.Data))
+	}
+	if result.Pagination.Page != 1 || result.Pagination.Limit != 20 || result.Pagination.Total != 1 {
+		t.Errorf("GetProjects() pagination = %+v, want page 1 limit 20 total 1", result.Pagination)
 	}
 }
 
@@ -517,8 +907,8 @@ func TestGetProjects_Empty(t *testing.T) {
 	router := setupTestRouter(t)
 	router.GET("/projects", handler.GetProjects)
 
-	mockRepo.getAllProjectsFunc = func(ctx context.Context) ([]models.PortfolioProject, error) {
-		return []models.PortfolioProject{}, nil
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		return []models.PortfolioProject{}, 0, nil
 	}
 
 	w := performRequest(t, router, "GET", "/projects", nil)
@@ -526,6 +916,9 @@ func TestGetProjects_Empty(t *testing.T) {
 	if w.Code != http.StatusOK {
 		t.Errorf("GetProjects() status = %d, want %d", w.Code, http.StatusOK)
 	}
+	if !strings.Contains(w.Body.String(), `"data":[]`) {
+		t.Errorf("GetProjects() body = %s, want an empty data array", w.Body.String())
+	}
 }
 
 func TestGetProjects_RepositoryError(t *testing.T) {
@@ -533,8 +926,8 @@ func TestGetProjects_RepositoryError(t *testing.T) {
 	router := setupTestRouter(t)
 	router.GET("/projects", handler.GetProjects)
 
-	mockRepo.getAllProjectsFunc = func(ctx context.Context) ([]models.PortfolioProject, error) {
-		return nil, errors.New("database error")
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		return nil, 0, errors.New("database error")
 	}
 
 	w := performRequest(t, router, "GET", "/projects", nil)
@@ -544,59 +937,140 @@ func TestGetProjects_RepositoryError(t *testing.T) {
 	}
 }
 
-func TestGetProjectByID_Success(t *testing.T) {
+func TestGetProjects_Paginated(t *testing.T) {
 	handler, mockRepo := setupTestHandler(t)
 	router := setupTestRouter(t)
-	router.GET("/projects/:id", handler.GetProjectByID)
+	router.GET("/projects", handler.GetProjects)
 
-	expectedProject := createTestProject()
-	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
-		if id != 1 {
-			return nil, gorm.ErrRecordNotFound
-		}
-		return &expectedProject, nil
+	var receivedFilter repository.ProjectFilter
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		receivedFilter = filter
+		return []models.PortfolioProject{createTestProject()}, 11, nil
 	}
 
-	w := performRequest(t, router, "GET", "/projects/1", nil)
+	w := performRequest(t, router, "GET", "/projects?page=2&limit=5&featured=true&technology=Go&sort=-startDate", nil)
 
 	if w.Code != http.StatusOK {
-		t.Errorf("GetProjectByID() status = %d, want %d", w.Code, http.StatusOK)
+		t.Fatalf("GetProjects() status = %d, want %d", w.Code, http.StatusOK)
 	}
 
-	var result models.PortfolioProject
+	if receivedFilter.Page != 2 || receivedFilter.Limit != 5 {
+		t.Errorf("GetProjects() pagination = %+v, want page 2 limit 5", receivedFilter.Pagination)
+	}
+	if receivedFilter.Featured == nil || !*receivedFilter.Featured {
+		t.Error("GetProjects() featured filter was not passed to repository")
+	}
+	if receivedFilter.Technology != "Go" || receivedFilter.Sort != "-startDate" {
+		t.Errorf("GetProjects() filter = %+v, want technology Go and sort -startDate", receivedFilter)
+	}
+
+	var result models.PaginatedResponse[models.PortfolioProject]
 	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
 
-	if result.Title != testProjectName {
-		t.Errorf("GetProjectByID() title = %s, want %s", result.Title, testProjectName)
+	if result.Pagination.Total != 11 || result.Pagination.TotalPages != 3 {
+		t.Errorf("GetProjects() pagination = %+v, want total 11 and 3 pages", result.Pagination)
+	}
+	if len(result.Data) != 1 {
+		t.Errorf("GetProjects() returned %d items, want 1", len(result.Data))
 	}
 }
 
-func TestGetProjectByID_NotFound(t *testing.T) {
+func TestGetProjects_InvalidQuery(t *testing.T) {
 	handler, mockRepo := setupTestHandler(t)
 	router := setupTestRouter(t)
-	router.GET("/projects/:id", handler.GetProjectByID)
+	router.GET("/projects", handler.GetProjects)
 
-	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
-		return nil, gorm.ErrRecordNotFound
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		if filter.Sort != "" {
+			return nil, 0, repository.ErrInvalidSort
+		}
+		return []models.PortfolioProject{}, 0, nil
 	}
 
-	w := performRequest(t, router, "GET", "/projects/999", nil)
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"zero page", "?page=0"},
+		{"non-numeric limit", "?limit=abc"},
+		{"limit above maximum", "?limit=1000"},
+		{"invalid featured", "?featured=maybe"},
+		{"invalid isOngoing", "?isOngoing=sometimes"},
+		{"unknown sort field", "?sort=password"},
+	}
 
-	if w.Code != http.StatusNotFound {
-		t.Errorf("GetProjectByID() status = %d, want %d", w.Code, http.StatusNotFound)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performRequest(t, router, "GET", "/projects"+tt.query, nil)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("GetProjects(%s) status = %d, want %d", tt.query, w.Code, http.StatusBadRequest)
+			}
+		})
 	}
 }
 
-func TestGetProjectByID_InvalidID(t *testing.T) {
-	handler, _ := setupTestHandler(t)
+func TestGetProjectByID_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
 	router := setupTestRouter(t)
 	router.GET("/projects/:id", handler.GetProjectByID)
 
-	w := performRequest(t, router, "GET", "/projects/invalid", nil)
-
-	if w.Code != http.StatusBadRequest {
+	expectedProject := createTestProject()
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		if id != 1 {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return &expectedProject, nil
+	}
+	mockRepo.getProjectSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "portfolio-website"}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/projects/1", nil)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("GetProjectByID() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result models.ProjectDetail
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if result.Title != testProjectName {
+		t.Errorf("GetProjectByID() title = %s, want %s", result.Title, testProjectName)
+	}
+	if result.Slug != "portfolio-website" {
+		t.Errorf("GetProjectByID() slug = %s, want portfolio-website", result.Slug)
+	}
+}
+
+func TestGetProjectByID_NotFound(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/projects/:id", handler.GetProjectByID)
+
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	w := performRequest(t, router, "GET", "/projects/999", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetProjectByID() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetProjectByID_InvalidID(t *testing.T) {
+	handler, _ := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/projects/:id", handler.GetProjectByID)
+
+	w := performRequest(t, router, "GET", "/projects/invalid", nil)
+
+	if w.Code != http.StatusBadRequest {
 		t.Errorf("GetProjectByID() status = %d, want %d", w.Code, http.StatusBadRequest)
 	}
 }
@@ -627,8 +1101,8 @@ func TestGetMiniatures_Success(t *testing.T) {
 	router.GET("/miniatures", handler.GetMiniatures)
 
 	expectedMiniatures := []models.MiniatureProject{createTestMiniatureProject()}
-	mockRepo.getAllMiniatureProjectsFunc = func(ctx context.Context) ([]models.MiniatureProject, error) {
-		return expectedMiniatures, nil
+	mockRepo.listMiniatureProjectsFunc = func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+		return expectedMiniatures, int64(len(expectedMiniatures)), nil
 	}
 
 	w := performRequest(t, router, "GET", "/miniatures", nil)
@@ -652,8 +1126,8 @@ func TestGetMiniatures_RepositoryError(t *testing.T) {
 	router := setupTestRouter(t)
 	router.GET("/miniatures", handler.GetMiniatures)
 
-	mockRepo.getAllMiniatureProjectsFunc = func(ctx context.Context) ([]models.MiniatureProject, error) {
-		return nil, errors.New("database error")
+	mockRepo.listMiniatureProjectsFunc = func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+		return nil, 0, errors.New("database error")
 	}
 
 	w := performRequest(t, router, "GET", "/miniatures", nil)
@@ -663,6 +1137,60 @@ func TestGetMiniatures_RepositoryError(t *testing.T) {
 	}
 }
 
+func TestGetMiniatures_Filtered(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures", handler.GetMiniatures)
+
+	var receivedFilter repository.MiniatureFilter
+	mockRepo.listMiniatureProjectsFunc = func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+		receivedFilter = filter
+		return []models.MiniatureProject{createTestMiniatureProject()}, 1, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures?themeId=3&scale=28mm&paintId=7&limit=10", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetMiniatures() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	if receivedFilter.ThemeID == nil || *receivedFilter.ThemeID != 3 {
+		t.Error("GetMiniatures() themeId filter was not passed to repository")
+	}
+	if receivedFilter.PaintID == nil || *receivedFilter.PaintID != 7 {
+		t.Error("GetMiniatures() paintId filter was not passed to repository")
+	}
+	if receivedFilter.TechniqueID != nil {
+		t.Error("GetMiniatures() techniqueId filter should be nil when absent")
+	}
+	if receivedFilter.Scale != "28mm" || receivedFilter.Page != 1 || receivedFilter.Limit != 10 {
+		t.Errorf("GetMiniatures() filter = %+v, want scale 28mm page 1 limit 10", receivedFilter)
+	}
+
+	var result models.PaginatedResponse[models.MiniatureProject]
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if result.Pagination.Total != 1 || len(result.Data) != 1 {
+		t.Errorf("GetMiniatures() returned %+v, want 1 item", result.Pagination)
+	}
+}
+
+func TestGetMiniatures_InvalidFilter(t *testing.T) {
+	handler, _ := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures", handler.GetMiniatures)
+
+	for _, query := range []string{"?themeId=abc", "?techniqueId=0", "?paintId=-1", "?page=x"} {
+		w := performRequest(t, router, "GET", "/miniatures"+query, nil)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("GetMiniatures(%s) status = %d, want %d", query, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
 func TestGetMiniatureByID_Success(t *testing.T) {
 	handler, mockRepo := setupTestHandler(t)
 	router := setupTestRouter(t)
@@ -672,6 +1200,9 @@ func TestGetMiniatureByID_Success(t *testing.T) {
 	mockRepo.getMiniatureProjectByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureProject, error) {
 		return &expectedMiniature, nil
 	}
+	mockRepo.getMiniatureSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "space-marine"}, nil
+	}
 
 	w := performRequest(t, router, "GET", "/miniatures/1", nil)
 
@@ -679,7 +1210,7 @@ func TestGetMiniatureByID_Success(t *testing.T) {
 		t.Errorf("GetMiniatureByID() status = %d, want %d", w.Code, http.StatusOK)
 	}
 
-	var result models.MiniatureProject
+	var result models.MiniatureDetail
 	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
@@ -687,6 +1218,9 @@ func TestGetMiniatureByID_Success(t *testing.T) {
 	if result.Title != testMiniatureName {
 		t.Errorf("GetMiniatureByID() title = %s, want %s", result.Title, testMiniatureName)
 	}
+	if result.Slug != "space-marine" {
+		t.Errorf("GetMiniatureByID() slug = %s, want space-marine", result.Slug)
+	}
 }
 
 func TestGetMiniatureByID_NotFound(t *testing.T) {
@@ -804,6 +1338,9 @@ func TestGetMiniatureThemeByID_Success(t *testing.T) {
 	mockRepo.getMiniatureThemeByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureTheme, error) {
 		return &expectedTheme, nil
 	}
+	mockRepo.getThemeSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "warhammer-40k"}, nil
+	}
 
 	w := performRequest(t, router, "GET", "/miniatures/themes/1", nil)
 
@@ -811,7 +1348,7 @@ func TestGetMiniatureThemeByID_Success(t *testing.T) {
 		t.Errorf("GetMiniatureThemeByID() status = %d, want %d", w.Code, http.StatusOK)
 	}
 
-	var result models.MiniatureTheme
+	var result models.ThemeDetail
 	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
 		t.Fatalf("Failed to unmarshal response: %v", err)
 	}
@@ -819,6 +1356,9 @@ func TestGetMiniatureThemeByID_Success(t *testing.T) {
 	if result.Name != testMiniatureTheme {
 		t.Errorf("GetMiniatureThemeByID() name = %s, want %s", result.Name, testMiniatureTheme)
 	}
+	if result.Slug != "warhammer-40k" {
+		t.Errorf("GetMiniatureThemeByID() slug = %s, want warhammer-40k", result.Slug)
+	}
 }
 
 func TestGetMiniatureThemeByID_NotFound(t *testing.T) {
@@ -865,6 +1405,1916 @@ func TestGetMiniatureThemeByID_RepositoryError(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Portfolio Bundle Handler Tests
+// =============================================================================
+
+func setupPortfolioMocks(mockRepo *mockRepository) {
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		profile := createTestProfile()
+		return &profile, nil
+	}
+	mockRepo.getAllWorkExperienceFunc = func(ctx context.Context) ([]models.WorkExperience, error) {
+		return []models.WorkExperience{createTestWorkExperience()}, nil
+	}
+	mockRepo.getAllCertificationsFunc = func(ctx context.Context) ([]models.Certification, error) {
+		return []models.Certification{createTestCertification()}, nil
+	}
+	mockRepo.getAllSkillsFunc = func(ctx context.Context) ([]models.Skill, error) {
+		return []models.Skill{createTestSkill()}, nil
+	}
+	mockRepo.getAllProjectsFunc = func(ctx context.Context) ([]models.PortfolioProject, error) {
+		return []models.PortfolioProject{createTestProject()}, nil
+	}
+}
+
+func TestGetPortfolio_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/portfolio", handler.GetPortfolio)
+	setupPortfolioMocks(mockRepo)
+
+	w := performRequest(t, router, "GET", "/portfolio", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetPortfolio() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result models.PortfolioBundle
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if result.Profile == nil || result.Profile.FullName != testProfileName {
+		t.Errorf("GetPortfolio() profile = %+v, want %s", result.Profile, testProfileName)
+	}
+	if len(result.Experience) != 1 || len(result.Certifications) != 1 || len(result.Skills) != 1 || len(result.Projects) != 1 {
+		t.Errorf("GetPortfolio() returned incomplete sections: %+v", result)
+	}
+	if len(result.Errors) != 0 {
+		t.Errorf("GetPortfolio() errors = %v, want none", result.Errors)
+	}
+}
+
+func TestGetPortfolio_PartialFailure(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/portfolio", handler.GetPortfolio)
+	setupPortfolioMocks(mockRepo)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	mockRepo.getAllSkillsFunc = func(ctx context.Context) ([]models.Skill, error) {
+		return nil, errors.New("database error")
+	}
+
+	w := performRequest(t, router, "GET", "/portfolio", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetPortfolio() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("GetPortfolio() Cache-Control = %q, want no-store for partial response", got)
+	}
+
+	var result models.PortfolioBundle
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if result.Errors["profile"] != "profile not found" {
+		t.Errorf("GetPortfolio() profile error = %q, want not found", result.Errors["profile"])
+	}
+	if result.Errors["skills"] != "failed to fetch skills" {
+		t.Errorf("GetPortfolio() skills error = %q, want failed to fetch skills", result.Errors["skills"])
+	}
+	if len(result.Projects) != 1 {
+		t.Errorf("GetPortfolio() projects = %d, want successful sections kept", len(result.Projects))
+	}
+}
+
+func TestGetPortfolio_AllSectionsFail(t *testing.T) {
+	handler, _ := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/portfolio", handler.GetPortfolio)
+
+	// Every mock function is unset, so each section returns "not implemented"
+	w := performRequest(t, router, "GET", "/portfolio", nil)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("GetPortfolio() status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestGetPortfolio_SharedDeadline(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/portfolio", handler.GetPortfolio)
+	setupPortfolioMocks(mockRepo)
+
+	var mu sync.Mutex
+	deadlines := make(map[time.Time]int)
+	mockRepo.getAllCertificationsFunc = func(ctx context.Context) ([]models.Certification, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return nil, errors.New("no deadline")
+		}
+		mu.Lock()
+		deadlines[deadline]++
+		mu.Unlock()
+		return []models.Certification{}, nil
+	}
+	mockRepo.getAllSkillsFunc = func(ctx context.Context) ([]models.Skill, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return nil, errors.New("no deadline")
+		}
+		mu.Lock()
+		deadlines[deadline]++
+		mu.Unlock()
+		return []models.Skill{}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/portfolio", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetPortfolio() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if len(deadlines) != 1 {
+		t.Errorf("GetPortfolio() sections saw %d distinct deadlines, want 1 shared deadline", len(deadlines))
+	}
+}
+
+// =============================================================================
+// Search Handler Tests
+// =============================================================================
+
+func TestSearch_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/search", handler.Search)
+
+	var gotQuery string
+	var gotTypes []string
+	var gotLimit int
+	mockRepo.searchFunc = func(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error) {
+		gotQuery, gotTypes, gotLimit = query, types, limit
+		return []models.SearchResult{
+			{Type: models.SearchTypeProject, ID: 1, Title: "Portfolio", Snippet: "A <mark>Go</mark> API", Rank: 0.6},
+			{Type: models.SearchTypeSkill, ID: 3, Title: "Go", Snippet: "<mark>Go</mark>", Rank: 0.3},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/search?q=%20go%20&types=project,skill&limit=5", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if gotQuery != "go" {
+		t.Errorf("query = %q, want trimmed %q", gotQuery, "go")
+	}
+	if len(gotTypes) != 2 || gotTypes[0] != "project" || gotTypes[1] != "skill" {
+		t.Errorf("types = %v, want [project skill]", gotTypes)
+	}
+	if gotLimit != 5 {
+		t.Errorf("limit = %d, want 5", gotLimit)
+	}
+
+	var response []models.SearchResult
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(response) != 2 || response[0].Type != "project" {
+		t.Errorf("response = %+v, want project result first", response)
+	}
+}
+
+func TestSearch_DefaultLimit(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/search", handler.Search)
+
+	mockRepo.searchFunc = func(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error) {
+		if types != nil {
+			t.Errorf("types = %v, want nil for all types", types)
+		}
+		if limit != defaultSearchLimit {
+			t.Errorf("limit = %d, want %d", limit, defaultSearchLimit)
+		}
+		return []models.SearchResult{}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/search?q=painting", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w.Body.String() != "[]" {
+		t.Errorf("body = %s, want []", w.Body.String())
+	}
+}
+
+func TestSearch_InvalidParams(t *testing.T) {
+	handler, _ := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/search", handler.Search)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"missing query", "/search"},
+		{"blank query", "/search?q=%20%20"},
+		{"query too long", "/search?q=" + strings.Repeat("a", maxSearchQueryLen+1)},
+		{"unknown type", "/search?q=go&types=project,blog"},
+		{"zero limit", "/search?q=go&limit=0"},
+		{"limit over max", "/search?q=go&limit=51"},
+		{"non-numeric limit", "/search?q=go&limit=ten"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performRequest(t, router, "GET", tt.path, nil)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestSearch_RepositoryError(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/search", handler.Search)
+
+	mockRepo.searchFunc = func(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error) {
+		return nil, errors.New("syntax error in tsquery")
+	}
+
+	w := performRequest(t, router, "GET", "/search?q=go", nil)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+// =============================================================================
+// Resume Handler Tests
+// =============================================================================
+
+func TestGetResumeJSON_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/resume.json", handler.GetResumeJSON)
+	setupPortfolioMocks(mockRepo)
+
+	w := performRequest(t, router, "GET", "/resume.json", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetResumeJSON() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result resume.Resume
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if result.Schema != resume.SchemaURL {
+		t.Errorf("GetResumeJSON() $schema = %s, want %s", result.Schema, resume.SchemaURL)
+	}
+	if result.Basics.Name != testProfileName {
+		t.Errorf("GetResumeJSON() name = %s, want %s", result.Basics.Name, testProfileName)
+	}
+	if len(result.Work) != 1 || len(result.Certificates) != 1 || len(result.Skills) != 1 || len(result.Projects) != 1 {
+		t.Errorf("GetResumeJSON() returned incomplete sections: %+v", result)
+	}
+}
+
+func TestGetResumeJSON_ProfileNotFound(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/resume.json", handler.GetResumeJSON)
+	setupPortfolioMocks(mockRepo)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	w := performRequest(t, router, "GET", "/resume.json", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetResumeJSON() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetResumeJSON_SectionError(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/resume.json", handler.GetResumeJSON)
+	setupPortfolioMocks(mockRepo)
+
+	mockRepo.getAllCertificationsFunc = func(ctx context.Context) ([]models.Certification, error) {
+		return nil, errors.New("database error")
+	}
+
+	w := performRequest(t, router, "GET", "/resume.json", nil)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("GetResumeJSON() status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+func TestGetResumePDF_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/resume.pdf", handler.GetResumePDF)
+	setupPortfolioMocks(mockRepo)
+
+	w := performRequest(t, router, "GET", "/resume.pdf", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetResumePDF() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/pdf" {
+		t.Errorf("GetResumePDF() Content-Type = %s, want application/pdf", got)
+	}
+	if !bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")) {
+		t.Error("GetResumePDF() body is not a PDF")
+	}
+}
+
+func TestGetResumePDF_UploadedSource(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/resume.pdf", handler.GetResumePDF)
+	setupPortfolioMocks(mockRepo)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		profile := createTestProfile()
+		profile.ResumeFile = &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 9, URL: "http://files/documents/resume.pdf"}}
+		return &profile, nil
+	}
+
+	w := performRequest(t, router, "GET", "/resume.pdf?source=upload", nil)
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("GetResumePDF() status = %d, want %d", w.Code, http.StatusFound)
+	}
+	if got := w.Header().Get("Location"); got != "http://files/documents/resume.pdf" {
+		t.Errorf("GetResumePDF() Location = %s, want uploaded file URL", got)
+	}
+}
+
+func TestGetResumePDF_UploadedSourceMissing(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/resume.pdf", handler.GetResumePDF)
+	setupPortfolioMocks(mockRepo)
+
+	w := performRequest(t, router, "GET", "/resume.pdf?source=upload", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetResumePDF() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+// =============================================================================
+// Feed Handler Tests
+// =============================================================================
+
+func setupFeedMocks(mockRepo *mockRepository) {
+	created := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		profile := createTestProfile()
+		return &profile, nil
+	}
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		project := createTestProject()
+		project.CreatedAt = created
+		project.ImageFile = &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 3, URL: "https://api.example.com/api/v1/img/3", MimeType: "image/png", FileSize: 2048}}
+		return []models.PortfolioProject{project}, 1, nil
+	}
+	mockRepo.listMiniatureProjectsFunc = func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+		miniature := createTestMiniatureProject()
+		miniature.CreatedAt = created.Add(time.Hour)
+		return []models.MiniatureProject{miniature}, 1, nil
+	}
+}
+
+func TestGetFeedAtom_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithSiteURL("https://example.com"))
+	router := setupTestRouter(t)
+	router.GET("/feed.atom", handler.GetFeedAtom)
+	setupFeedMocks(mockRepo)
+
+	var projectFilter repository.ProjectFilter
+	listProjects := mockRepo.listProjectsFunc
+	mockRepo.listProjectsFunc = func(ctx context.Context, filter repository.ProjectFilter) ([]models.PortfolioProject, int64, error) {
+		projectFilter = filter
+		return listProjects(ctx, filter)
+	}
+
+	w := performRequest(t, router, "GET", "/feed.atom?limit=5", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetFeedAtom() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/atom+xml; charset=utf-8" {
+		t.Errorf("GetFeedAtom() Content-Type = %s, want application/atom+xml", got)
+	}
+	if projectFilter.Sort != "-createdAt" || projectFilter.Limit != 5 {
+		t.Errorf("ListProjects() filter = %+v, want newest 5", projectFilter)
+	}
+
+	var doc struct {
+		Entries []struct {
+			ID string `xml:"id"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to parse feed: %v", err)
+	}
+	if len(doc.Entries) != 2 || doc.Entries[0].ID != "https://example.com/miniatures/1" || doc.Entries[1].ID != "https://example.com/projects/1" {
+		t.Errorf("GetFeedAtom() entries = %+v, want miniature then project", doc.Entries)
+	}
+}
+
+func TestGetFeedJSON_WithoutProfile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithSiteURL("https://example.com"), WithAPIURL("https://api.example.com/api/v1"))
+	router := setupTestRouter(t)
+	router.GET("/feed.json", handler.GetFeedJSON)
+	setupFeedMocks(mockRepo)
+
+	mockRepo.getProfileFunc = func(ctx context.Context) (*models.Profile, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	w := performRequest(t, router, "GET", "/feed.json", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetFeedJSON() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var doc struct {
+		Title   string `json:"title"`
+		FeedURL string `json:"feed_url"`
+		Items   []struct {
+			URL         string `json:"url"`
+			Image       string `json:"image"`
+			Attachments []struct {
+				MimeType    string `json:"mime_type"`
+				SizeInBytes int64  `json:"size_in_bytes"`
+			} `json:"attachments"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to unmarshal feed: %v", err)
+	}
+	if doc.Title != "Portfolio" || len(doc.Items) != 2 {
+		t.Errorf("GetFeedJSON() = %+v, want default title and 2 items", doc)
+	}
+	if doc.Items[1].URL != "https://example.com/projects/1" || doc.FeedURL != "https://api.example.com/api/v1/feed.json" {
+		t.Errorf("GetFeedJSON() item URL = %s, feed URL = %s, want configured origins", doc.Items[1].URL, doc.FeedURL)
+	}
+	// The enclosure is the JPEG rendition, whatever the original's type and size
+	if doc.Items[1].Image != "https://api.example.com/api/v1/img/3?fmt=jpeg" {
+		t.Errorf("GetFeedJSON() image = %s, want the JPEG rendition URL", doc.Items[1].Image)
+	}
+	if attachments := doc.Items[1].Attachments; len(attachments) != 1 || attachments[0].MimeType != "image/jpeg" || attachments[0].SizeInBytes != 0 {
+		t.Errorf("GetFeedJSON() attachments = %+v, want image/jpeg without a size", attachments)
+	}
+}
+
+func TestGetFeedRSS_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/feed.rss", handler.GetFeedRSS)
+	setupFeedMocks(mockRepo)
+
+	// Links are never built from the client's Host header
+	w := performRequest(t, router, "GET", "/feed.rss", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetFeedRSS() without site URL status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	handler = New(mockRepo, WithSiteURL("https://example.com"))
+	router = setupTestRouter(t)
+	router.GET("/feed.rss", handler.GetFeedRSS)
+
+	w = performRequest(t, router, "GET", "/feed.rss?limit=0", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("GetFeedRSS() invalid limit status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	mockRepo.listMiniatureProjectsFunc = func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+		return nil, 0, errors.New("database error")
+	}
+
+	w = performRequest(t, router, "GET", "/feed.rss", nil)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("GetFeedRSS() status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+// =============================================================================
+// Sitemap Handler Tests
+// =============================================================================
+
+func TestGetSitemap_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithSiteURL("https://example.com"))
+	router := setupTestRouter(t)
+	router.GET("/sitemap.xml", handler.GetSitemap)
+
+	updated := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mockRepo.getSitemapPagesFunc = func(ctx context.Context) (*models.SitemapPages, error) {
+		return &models.SitemapPages{
+			Projects:   []models.PageStamp{{ID: 1, UpdatedAt: updated}},
+			Themes:     []models.PageStamp{{ID: 1, UpdatedAt: updated.Add(-time.Hour)}},
+			Miniatures: []models.PageStamp{{ID: 1, UpdatedAt: updated.Add(time.Hour)}},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/sitemap.xml", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetSitemap() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var doc struct {
+		URLs []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"url"`
+	}
+	if err := xml.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to parse sitemap: %v", err)
+	}
+	want := []string{
+		"https://example.com/",
+		"https://example.com/projects/1",
+		"https://example.com/miniatures/themes/1",
+		"https://example.com/miniatures/1",
+	}
+	if len(doc.URLs) != len(want) {
+		t.Fatalf("GetSitemap() returned %d URLs, want %d", len(doc.URLs), len(want))
+	}
+	for i, loc := range want {
+		if doc.URLs[i].Loc != loc {
+			t.Errorf("URL %d = %s, want %s", i, doc.URLs[i].Loc, loc)
+		}
+	}
+	// The home page takes the newest lastmod of the pages it links to
+	if doc.URLs[0].LastMod != "2024-03-01T11:00:00Z" || doc.URLs[1].LastMod != "2024-03-01T10:00:00Z" {
+		t.Errorf("GetSitemap() lastmod = %s, %s", doc.URLs[0].LastMod, doc.URLs[1].LastMod)
+	}
+
+	w = performRequest(t, router, "GET", "/sitemap.xml?page=2", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetSitemap() page out of range status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetSitemap_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/sitemap.xml", handler.GetSitemap)
+
+	// Without a public site URL there is nothing to point the sitemap at
+	w := performRequest(t, router, "GET", "/sitemap.xml", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetSitemap() without site URL status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	handler = New(mockRepo, WithSiteURL("https://example.com"))
+	router = setupTestRouter(t)
+	router.GET("/sitemap.xml", handler.GetSitemap)
+
+	w = performRequest(t, router, "GET", "/sitemap.xml?page=x", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("GetSitemap() invalid page status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	mockRepo.getSitemapPagesFunc = func(ctx context.Context) (*models.SitemapPages, error) {
+		return nil, errors.New("database error")
+	}
+
+	w = performRequest(t, router, "GET", "/sitemap.xml", nil)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("GetSitemap() status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+// =============================================================================
+// Meta Handler Tests
+// =============================================================================
+
+func TestGetProjectMeta_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithSiteURL("https://example.com"), WithAPIURL("https://api.example.com/api/v1"))
+	router := setupTestRouter(t)
+	router.GET("/meta/projects/:id", handler.GetProjectMeta)
+
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		project := createTestProject()
+		project.ID = id
+		project.Description = strings.Repeat("word ", 60)
+		project.ImageFile = &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 3, URL: "http://files/images/project.png"}}
+		return &project, nil
+	}
+
+	w := performRequest(t, router, "GET", "/meta/projects/7", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetProjectMeta() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var meta models.PageMeta
+	if err := json.Unmarshal(w.Body.Bytes(), &meta); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if meta.Title != testProjectName || meta.URL != "https://example.com/projects/7" || meta.Image != "https://api.example.com/api/v1/og/projects/7.png" {
+		t.Errorf("GetProjectMeta() = %+v", meta)
+	}
+	// Long descriptions are cut at a word boundary
+	if n := len([]rune(meta.Description)); n > maxMetaDescription || !strings.HasSuffix(meta.Description, "word…") {
+		t.Errorf("GetProjectMeta() description = %q (%d runes), want summary", meta.Description, n)
+	}
+}
+
+func TestGetMiniatureMeta_HTML(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithSiteURL("https://example.com"))
+	router := setupTestRouter(t)
+	router.GET("/meta/miniatures/:id", handler.GetMiniatureMeta)
+
+	mockRepo.getMiniatureProjectByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureProject, error) {
+		miniature := createTestMiniatureProject()
+		miniature.Title = `Knight "Errant" <Blue>`
+		miniature.Images = []models.Image{{Image: commonModels.Image{URL: "http://files/miniatures/knight.jpg"}}}
+		return &miniature, nil
+	}
+
+	w := performRequest(t, router, "GET", "/meta/miniatures/1?format=html", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetMiniatureMeta() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+		t.Errorf("GetMiniatureMeta() Content-Type = %s, want text/html", got)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		`<meta property="og:title" content="Knight &#34;Errant&#34; &lt;Blue&gt;">`,
+		`<meta property="og:url" content="https://example.com/miniatures/1">`,
+		`<meta property="og:image" content="http://files/miniatures/knight.jpg">`,
+		`<meta name="twitter:card" content="summary_large_image">`,
+		`<link rel="canonical" href="https://example.com/miniatures/1">`,
+		`<meta http-equiv="refresh" content="0; url=https://example.com/miniatures/1">`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("GetMiniatureMeta() HTML missing %s\n%s", want, body)
+		}
+	}
+}
+
+func TestGetThemeMeta_FallbackImage(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/meta/themes/:id", handler.GetThemeMeta)
+
+	mockRepo.getMiniatureThemeByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureTheme, error) {
+		theme := createTestMiniatureTheme()
+		withoutImages := createTestMiniatureProject()
+		withImages := createTestMiniatureProject()
+		withImages.Images = []models.Image{{Image: commonModels.Image{URL: "http://files/miniatures/first.jpg"}}}
+		theme.Miniatures = []models.MiniatureProject{withoutImages, withImages}
+		return &theme, nil
+	}
+
+	w := performRequest(t, router, "GET", "/meta/themes/1", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetThemeMeta() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var meta models.PageMeta
+	if err := json.Unmarshal(w.Body.Bytes(), &meta); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if meta.Title != testMiniatureTheme || meta.Image != "http://files/miniatures/first.jpg" {
+		t.Errorf("GetThemeMeta() = %+v, want first miniature image", meta)
+	}
+	// Without a configured site URL the canonical link is omitted, not taken from Host
+	if meta.URL != "" {
+		t.Errorf("GetThemeMeta() URL = %s, want none without a site URL", meta.URL)
+	}
+}
+
+func TestGetMeta_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/meta/projects/:id", handler.GetProjectMeta)
+
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	w := performRequest(t, router, "GET", "/meta/projects/1?format=xml", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("GetProjectMeta() invalid format status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w = performRequest(t, router, "GET", "/meta/projects/99?format=html", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetProjectMeta() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetMeta_HTMLWithoutSiteURL(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/meta/miniatures/:id", handler.GetMiniatureMeta)
+
+	mockRepo.getMiniatureProjectByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureProject, error) {
+		miniature := createTestMiniatureProject()
+		return &miniature, nil
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/meta/miniatures/1?format=html", nil)
+	req.Host = "attacker.example"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetMiniatureMeta() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	// Browsers are not redirected anywhere, least of all to the request's Host
+	body := w.Body.String()
+	for _, unwanted := range []string{`http-equiv="refresh"`, `rel="canonical"`, "attacker.example"} {
+		if strings.Contains(body, unwanted) {
+			t.Errorf("GetMiniatureMeta() HTML contains %s\n%s", unwanted, body)
+		}
+	}
+}
+
+// =============================================================================
+// Open Graph Image Handler Tests
+// =============================================================================
+
+func TestGetProjectOGImage_Success(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 40, 30))); err != nil {
+		t.Fatal(err)
+	}
+	var downloads atomic.Int32
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/og/projects/:file", handler.GetProjectOGImage)
+
+	var lookups atomic.Int32
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		lookups.Add(1)
+		project := createTestProject()
+		project.ImageFile = &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 3}, SourceURL: files.URL + "/images/project.png"}
+		project.Technologies = []models.Skill{{ID: 1, Skill: "Go", Type: "Backend"}}
+		return &project, nil
+	}
+
+	for range 2 {
+		w := performRequest(t, router, "GET", "/og/projects/1.png", nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("GetProjectOGImage() status = %d, want %d", w.Code, http.StatusOK)
+		}
+		if got := w.Header().Get("Content-Type"); got != "image/png" {
+			t.Errorf("GetProjectOGImage() Content-Type = %s, want image/png", got)
+		}
+		config, err := png.DecodeConfig(w.Body)
+		if err != nil || config.Width != 1200 || config.Height != 630 {
+			t.Errorf("GetProjectOGImage() image = %dx%d (%v), want 1200x630", config.Width, config.Height, err)
+		}
+	}
+
+	// The second request is served from the rendered image cache
+	if lookups.Load() != 2 || downloads.Load() != 1 {
+		t.Errorf("lookups = %d, downloads = %d, want 2 lookups and 1 download", lookups.Load(), downloads.Load())
+	}
+}
+
+func TestGetProjectOGImage_ImageUnavailable(t *testing.T) {
+	files := httptest.NewServer(http.NotFoundHandler())
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/og/projects/:file", handler.GetProjectOGImage)
+
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		project := createTestProject()
+		project.ImageFile = &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 3}, SourceURL: files.URL + "/images/missing.png"}
+		return &project, nil
+	}
+
+	w := performRequest(t, router, "GET", "/og/projects/1.png", nil)
+
+	// A text-only card is served instead of an error, but never kept
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "image/png" {
+		t.Errorf("GetProjectOGImage() status = %d, Content-Type = %s, want PNG", w.Code, w.Header().Get("Content-Type"))
+	}
+	if got := w.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("GetProjectOGImage() Cache-Control = %q, want no-store", got)
+	}
+}
+
+func TestGetProjectOGImage_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/og/projects/:file", handler.GetProjectOGImage)
+
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/og/projects/1.jpg", http.StatusNotFound},
+		{"/og/projects/abc.png", http.StatusBadRequest},
+		{"/og/projects/99.png", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", tt.path, nil)
+		if w.Code != tt.want {
+			t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, tt.want)
+		}
+	}
+}
+
+// =============================================================================
+// Slug Handler Tests
+// =============================================================================
+
+func TestGetBySlug_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	// Registered next to the ID routes, as in production
+	router.GET("/projects/:id", handler.GetProjectByID)
+	router.GET("/projects/by-slug/:slug", handler.GetProjectBySlug)
+	router.GET("/miniatures/projects/:id", handler.GetMiniatureByID)
+	router.GET("/miniatures/projects/by-slug/:slug", handler.GetMiniatureBySlug)
+	router.GET("/miniatures/themes/:id", handler.GetMiniatureThemeByID)
+	router.GET("/miniatures/themes/by-slug/:slug", handler.GetMiniatureThemeBySlug)
+
+	var requested []string
+	mockRepo.getProjectBySlugFunc = func(ctx context.Context, slug string) (*models.PortfolioProject, error) {
+		requested = append(requested, slug)
+		project := createTestProject()
+		return &project, nil
+	}
+	mockRepo.getMiniatureBySlugFunc = func(ctx context.Context, slug string) (*models.MiniatureProject, error) {
+		requested = append(requested, slug)
+		miniature := createTestMiniatureProject()
+		return &miniature, nil
+	}
+	mockRepo.getThemeBySlugFunc = func(ctx context.Context, slug string) (*models.MiniatureTheme, error) {
+		requested = append(requested, slug)
+		theme := createTestMiniatureTheme()
+		return &theme, nil
+	}
+	mockRepo.getProjectSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "portfolio-website"}, nil
+	}
+	mockRepo.getMiniatureSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "space-marine"}, nil
+	}
+	mockRepo.getThemeSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "warhammer-40k"}, nil
+	}
+
+	for _, path := range []string{
+		"/projects/by-slug/portfolio-website",
+		"/miniatures/projects/by-slug/space-marine",
+		"/miniatures/themes/by-slug/warhammer-40k",
+	} {
+		w := performRequest(t, router, "GET", path, nil)
+		if w.Code != http.StatusOK {
+			t.Errorf("GET %s status = %d, want %d", path, w.Code, http.StatusOK)
+			continue
+		}
+
+		var result struct {
+			ID   int64  `json:"id"`
+			Slug string `json:"slug"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if result.ID != 1 || !strings.HasSuffix(path, "/"+result.Slug) {
+			t.Errorf("GET %s = %+v, want ID 1 with the requested slug", path, result)
+		}
+	}
+
+	want := []string{"portfolio-website", "space-marine", "warhammer-40k"}
+	if strings.Join(requested, ",") != strings.Join(want, ",") {
+		t.Errorf("requested slugs = %v, want %v", requested, want)
+	}
+}
+
+func TestGetBySlug_RedirectsToCanonicalSlug(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/projects/by-slug/:slug", handler.GetProjectBySlug)
+	router.GET("/miniatures/themes/by-slug/:slug", handler.GetMiniatureThemeBySlug)
+
+	// The repository resolves older forms of a slug to the record they name
+	mockRepo.getProjectBySlugFunc = func(ctx context.Context, slug string) (*models.PortfolioProject, error) {
+		project := createTestProject()
+		return &project, nil
+	}
+	mockRepo.getThemeBySlugFunc = func(ctx context.Context, slug string) (*models.MiniatureTheme, error) {
+		theme := createTestMiniatureTheme()
+		return &theme, nil
+	}
+	mockRepo.getProjectSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "portfolio-website"}, nil
+	}
+	mockRepo.getThemeSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return map[int64]string{1: "warhammer-40k"}, nil
+	}
+
+	tests := []struct {
+		path     string
+		location string
+	}{
+		{"/projects/by-slug/1", "/projects/by-slug/portfolio-website"},
+		{"/projects/by-slug/1-old-title", "/projects/by-slug/portfolio-website"},
+		{"/miniatures/themes/by-slug/warhammer-40k-1?fields=name", "/miniatures/themes/by-slug/warhammer-40k?fields=name"},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", tt.path, nil)
+		if w.Code != http.StatusMovedPermanently {
+			t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, http.StatusMovedPermanently)
+			continue
+		}
+		if got := w.Header().Get("Location"); got != tt.location {
+			t.Errorf("GET %s Location = %s, want %s", tt.path, got, tt.location)
+		}
+	}
+}
+
+func TestGetBySlug_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/projects/:id", handler.GetProjectByID)
+	router.GET("/projects/by-slug/:slug", handler.GetProjectBySlug)
+
+	mockRepo.getProjectBySlugFunc = func(ctx context.Context, slug string) (*models.PortfolioProject, error) {
+		return nil, fmt.Errorf("failed to get project by slug %q: %w", slug, gorm.ErrRecordNotFound)
+	}
+
+	w := performRequest(t, router, "GET", "/projects/by-slug/missing", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GetProjectBySlug() status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	// A project whose slug cannot be determined is not served without it
+	mockRepo.getProjectByIDFunc = func(ctx context.Context, id int64) (*models.PortfolioProject, error) {
+		project := createTestProject()
+		return &project, nil
+	}
+	mockRepo.getProjectSlugsFunc = func(ctx context.Context) (map[int64]string, error) {
+		return nil, errors.New("database error")
+	}
+
+	w = performRequest(t, router, "GET", "/projects/1", nil)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("GetProjectByID() status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
+
+// =============================================================================
+// Navigation Handler Tests
+// =============================================================================
+
+func TestGetProjectNavigation_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/projects/:id/navigation", handler.GetProjectNavigation)
+
+	var gotFilter repository.ProjectFilter
+	var gotLimit int
+	mockRepo.getProjectNavigationFunc = func(ctx context.Context, id int64, filter repository.ProjectFilter, relatedLimit int) (*models.Navigation, error) {
+		gotFilter, gotLimit = filter, relatedLimit
+		return &models.Navigation{
+			Previous: &models.NavItem{ID: 1, Slug: "first", Title: "First"},
+			Next:     &models.NavItem{ID: 3, Slug: "third", Title: "Third"},
+			Related:  []models.RelatedItem{{NavItem: models.NavItem{ID: 7, Slug: "seventh", Title: "Seventh"}, Score: 2}},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/projects/2/navigation?category=web&sort=-startDate&related=2", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetProjectNavigation() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if gotFilter.Category != "web" || gotFilter.Sort != "-startDate" || gotLimit != 2 {
+		t.Errorf("repository got filter %+v and limit %d", gotFilter, gotLimit)
+	}
+
+	var result models.Navigation
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if result.Previous == nil || result.Previous.Slug != "first" || result.Next == nil || result.Next.ID != 3 {
+		t.Errorf("neighbours = %+v, %+v", result.Previous, result.Next)
+	}
+	if len(result.Related) != 1 || result.Related[0].Score != 2 {
+		t.Errorf("Related = %+v, want one item with score 2", result.Related)
+	}
+}
+
+func TestGetMiniatureNavigation_Edges(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/projects/:id/navigation", handler.GetMiniatureNavigation)
+
+	var gotLimit int
+	mockRepo.getMiniatureNavigationFunc = func(ctx context.Context, id int64, filter repository.MiniatureFilter, relatedLimit int) (*models.Navigation, error) {
+		gotLimit = relatedLimit
+		if filter.ThemeID == nil || *filter.ThemeID != 4 {
+			t.Errorf("ThemeID = %v, want 4", filter.ThemeID)
+		}
+		return &models.Navigation{Related: []models.RelatedItem{}}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/projects/1/navigation?themeId=4", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetMiniatureNavigation() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if gotLimit != defaultRelatedLimit {
+		t.Errorf("related limit = %d, want default %d", gotLimit, defaultRelatedLimit)
+	}
+	// Missing neighbours are null and an empty related list stays an array
+	if body := w.Body.String(); !strings.Contains(body, `"previous":null`) || !strings.Contains(body, `"related":[]`) {
+		t.Errorf("body = %s", body)
+	}
+}
+
+func TestGetNavigation_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/projects/:id/navigation", handler.GetProjectNavigation)
+	router.GET("/miniatures/projects/:id/navigation", handler.GetMiniatureNavigation)
+
+	mockRepo.getProjectNavigationFunc = func(ctx context.Context, id int64, filter repository.ProjectFilter, relatedLimit int) (*models.Navigation, error) {
+		if filter.Sort != "" {
+			return nil, fmt.Errorf("%w: %s", repository.ErrInvalidSort, filter.Sort)
+		}
+		return nil, fmt.Errorf("failed to get project %d: %w", id, gorm.ErrRecordNotFound)
+	}
+	mockRepo.getMiniatureNavigationFunc = func(ctx context.Context, id int64, filter repository.MiniatureFilter, relatedLimit int) (*models.Navigation, error) {
+		return nil, errors.New("database error")
+	}
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/projects/1/navigation?related=-1", http.StatusBadRequest},
+		{"/projects/1/navigation?related=13", http.StatusBadRequest},
+		{"/projects/1/navigation?featured=maybe", http.StatusBadRequest},
+		{"/projects/1/navigation?sort=password", http.StatusBadRequest},
+		{"/projects/99/navigation", http.StatusNotFound},
+		{"/miniatures/projects/1/navigation?themeId=x", http.StatusBadRequest},
+		{"/miniatures/projects/1/navigation", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", tt.path, nil)
+		if w.Code != tt.want {
+			t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, tt.want)
+		}
+	}
+}
+
+// =============================================================================
+// Paint and Technique Catalogue Handler Tests
+// =============================================================================
+
+func TestGetPaints_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/paints", handler.GetPaints)
+
+	hex := "#1B3A6B"
+	mockRepo.getAllPaintsFunc = func(ctx context.Context) ([]models.PaintWithUsage, error) {
+		return []models.PaintWithUsage{
+			{MiniaturePaint: models.MiniaturePaint{ID: 1, Name: "Kantor Blue", Manufacturer: "Citadel", ColorHex: &hex}, MiniatureCount: 3},
+			{MiniaturePaint: models.MiniaturePaint{ID: 2, Name: "Unused", Manufacturer: "Vallejo"}},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/paints", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetPaints() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result []map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("GetPaints() returned %d paints, want 2", len(result))
+	}
+	if result[0]["name"] != "Kantor Blue" || result[0]["colorHex"] != hex || result[0]["miniatureCount"] != float64(3) {
+		t.Errorf("GetPaints()[0] = %v", result[0])
+	}
+	// Unused paints stay in the catalogue with a zero count
+	if result[1]["miniatureCount"] != float64(0) {
+		t.Errorf("GetPaints()[1] miniatureCount = %v, want 0", result[1]["miniatureCount"])
+	}
+}
+
+func TestGetPaintMiniatures_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/paints/:id", handler.GetPaintMiniatures)
+
+	mockRepo.getPaintMiniaturesFunc = func(ctx context.Context, id int64) (*models.PaintMiniatures, error) {
+		if id != 5 {
+			t.Errorf("GetPaintMiniatures() id = %d, want 5", id)
+		}
+		return &models.PaintMiniatures{
+			Paint:      models.PaintWithUsage{MiniaturePaint: models.MiniaturePaint{ID: 5, Name: "Kantor Blue"}, MiniatureCount: 1},
+			Miniatures: []models.MiniatureProject{createTestMiniatureProject()},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/paints/5", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetPaintMiniatures() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var result models.PaintMiniatures
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if result.Paint.Name != "Kantor Blue" || result.Paint.MiniatureCount != 1 {
+		t.Errorf("GetPaintMiniatures() paint = %+v", result.Paint)
+	}
+	if len(result.Miniatures) != 1 || result.Miniatures[0].Title != testMiniatureName {
+		t.Errorf("GetPaintMiniatures() miniatures = %+v", result.Miniatures)
+	}
+}
+
+func TestGetTechniqueMiniatures_Unused(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/techniques/:id", handler.GetTechniqueMiniatures)
+
+	mockRepo.getTechniqueMiniaturesFunc = func(ctx context.Context, id int64) (*models.TechniqueMiniatures, error) {
+		return &models.TechniqueMiniatures{
+			Technique:  models.TechniqueWithUsage{MiniatureTechnique: models.MiniatureTechnique{ID: id, Name: "Wet blending"}},
+			Miniatures: []models.MiniatureProject{},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/techniques/2", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetTechniqueMiniatures() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if body := w.Body.String(); !strings.Contains(body, `"miniatures":[]`) || !strings.Contains(body, `"miniatureCount":0`) {
+		t.Errorf("GetTechniqueMiniatures() body = %s", body)
+	}
+}
+
+func TestGetSimilarPaints_Success(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/paints/similar", handler.GetSimilarPaints)
+	router.GET("/miniatures/paints/:id", handler.GetPaintMiniatures)
+
+	hexes := map[int64]string{1: "#0000FF", 2: "#1B3A6B", 3: "#1C3B6A", 4: "not a colour"}
+	mockRepo.getAllPaintsFunc = func(ctx context.Context) ([]models.PaintWithUsage, error) {
+		paints := []models.PaintWithUsage{}
+		for id := int64(1); id <= 5; id++ {
+			paint := models.PaintWithUsage{MiniaturePaint: models.MiniaturePaint{ID: id, Name: fmt.Sprintf("Paint %d", id)}}
+			if hex, ok := hexes[id]; ok {
+				paint.ColorHex = &hex
+			}
+			paints = append(paints, paint)
+		}
+		return paints, nil
+	}
+	var gotFilter repository.MiniatureFilter
+	mockRepo.listMiniatureProjectsFunc = func(ctx context.Context, filter repository.MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+		gotFilter = filter
+		return []models.MiniatureProject{createTestMiniatureProject()}, 1, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/paints/similar?hex=%231b3a6b&limit=2&miniatures=true", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetSimilarPaints() status = %d, want %d: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var result models.SimilarPaints
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if result.Hex != "#1b3a6b" {
+		t.Errorf("Hex = %q, want #1b3a6b", result.Hex)
+	}
+	if len(result.Paints) != 2 || result.Paints[0].ID != 2 || result.Paints[1].ID != 3 {
+		t.Fatalf("Paints = %+v, want paints 2 and 3", result.Paints)
+	}
+	if result.Paints[0].DeltaE != 0 || result.Paints[1].DeltaE <= 0 || result.Paints[1].DeltaE > 2 {
+		t.Errorf("DeltaE = %v, %v; want 0 then a small positive distance", result.Paints[0].DeltaE, result.Paints[1].DeltaE)
+	}
+	if !slices.Equal(gotFilter.PaintIDs, []int64{2, 3}) {
+		t.Errorf("miniature filter PaintIDs = %v, want [2 3]", gotFilter.PaintIDs)
+	}
+	if result.Miniatures == nil || len(*result.Miniatures) != 1 {
+		t.Errorf("Miniatures = %v, want 1 miniature", result.Miniatures)
+	}
+
+	// A distance cap drops far paints, and miniatures are only loaded on request
+	w = performRequest(t, router, "GET", "/miniatures/paints/similar?hex=00F&maxDeltaE=5", nil)
+	result = models.SimilarPaints{}
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(result.Paints) != 1 || result.Paints[0].ID != 1 || strings.Contains(w.Body.String(), `"miniatures"`) {
+		t.Errorf("GET with maxDeltaE body = %s", w.Body.String())
+	}
+
+	// Requested miniatures are sent as an empty list when no paint is close enough
+	gotFilter = repository.MiniatureFilter{}
+	w = performRequest(t, router, "GET", "/miniatures/paints/similar?hex=%23ff0000&maxDeltaE=1&miniatures=true", nil)
+	if !strings.Contains(w.Body.String(), `"paints":[]`) || !strings.Contains(w.Body.String(), `"miniatures":[]`) {
+		t.Errorf("GET with no close paints body = %s, want empty paints and miniatures", w.Body.String())
+	}
+	if gotFilter.PaintIDs != nil {
+		t.Errorf("miniatures were loaded for no paints, filter = %+v", gotFilter)
+	}
+}
+
+func TestGetSimilarPaints_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/paints/similar", handler.GetSimilarPaints)
+
+	mockRepo.getAllPaintsFunc = func(ctx context.Context) ([]models.PaintWithUsage, error) {
+		return nil, errors.New("database error")
+	}
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"", http.StatusBadRequest},
+		{"hex=%23zzzzzz", http.StatusBadRequest},
+		{"hex=aabbcc&limit=0", http.StatusBadRequest},
+		{"hex=aabbcc&limit=51", http.StatusBadRequest},
+		{"hex=aabbcc&maxDeltaE=-1", http.StatusBadRequest},
+		{"hex=aabbcc&maxDeltaE=NaN", http.StatusBadRequest},
+		{"hex=aabbcc&miniatures=maybe", http.StatusBadRequest},
+		{"hex=aabbcc", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", "/miniatures/paints/similar?"+tt.query, nil)
+		if w.Code != tt.want {
+			t.Errorf("GET ?%s status = %d, want %d", tt.query, w.Code, tt.want)
+		}
+	}
+}
+
+func TestGetCatalogue_Errors(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/paints", handler.GetPaints)
+	router.GET("/miniatures/paints/:id", handler.GetPaintMiniatures)
+	router.GET("/miniatures/techniques", handler.GetTechniques)
+	router.GET("/miniatures/techniques/:id", handler.GetTechniqueMiniatures)
+
+	mockRepo.getAllPaintsFunc = func(ctx context.Context) ([]models.PaintWithUsage, error) {
+		return nil, errors.New("database error")
+	}
+	mockRepo.getAllTechniquesFunc = func(ctx context.Context) ([]models.TechniqueWithUsage, error) {
+		return nil, errors.New("database error")
+	}
+	mockRepo.getPaintMiniaturesFunc = func(ctx context.Context, id int64) (*models.PaintMiniatures, error) {
+		return nil, fmt.Errorf("failed to get paint by id %d: %w", id, gorm.ErrRecordNotFound)
+	}
+	mockRepo.getTechniqueMiniaturesFunc = func(ctx context.Context, id int64) (*models.TechniqueMiniatures, error) {
+		return nil, errors.New("database error")
+	}
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/miniatures/paints", http.StatusInternalServerError},
+		{"/miniatures/techniques", http.StatusInternalServerError},
+		{"/miniatures/paints/99", http.StatusNotFound},
+		{"/miniatures/techniques/1", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", tt.path, nil)
+		if w.Code != tt.want {
+			t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, tt.want)
+		}
+	}
+}
+
+// =============================================================================
+// Image Handler Tests
+// =============================================================================
+
+func TestGetImage_Success(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 800, 600))); err != nil {
+		t.Fatal(err)
+	}
+	var downloads atomic.Int32
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/img/:fileId", handler.GetImage)
+
+	mockRepo.getImageFileFunc = func(ctx context.Context, id int64) (*models.StorageFile, error) {
+		return &models.StorageFile{StorageFile: commonModels.StorageFile{ID: id, S3Key: "images/photo.png"}, SourceURL: files.URL + "/images/photo.png"}, nil
+	}
+
+	tests := []struct {
+		path          string
+		width, height int
+	}{
+		{"/img/5?w=320", 320, 240},
+		{"/img/5?w=320", 320, 240},
+		// Never enlarged beyond the original
+		{"/img/5?w=1600", 800, 600},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", tt.path, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s status = %d, want %d", tt.path, w.Code, http.StatusOK)
+		}
+		if got := w.Header().Get("Content-Type"); got != "image/jpeg" {
+			t.Errorf("GET %s Content-Type = %s, want image/jpeg", tt.path, got)
+		}
+		config, err := jpeg.DecodeConfig(w.Body)
+		if err != nil || config.Width != tt.width || config.Height != tt.height {
+			t.Errorf("GET %s image = %dx%d (%v), want %dx%d", tt.path, config.Width, config.Height, err, tt.width, tt.height)
+		}
+	}
+
+	// The repeated width is served from the resized image cache
+	if downloads.Load() != 2 {
+		t.Errorf("downloads = %d, want 2", downloads.Load())
+	}
+
+	// The ETag names the rendition, so revalidation answers 304 without rendering
+	first := performRequest(t, router, "GET", "/img/5?w=768", nil)
+	etag := first.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("GetImage() sent no ETag")
+	}
+	req := httptest.NewRequest(http.MethodGet, "/img/5?w=768", nil)
+	req.Header.Set("If-None-Match", etag)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("conditional GET status = %d with %d bytes, want 304 without a body", w.Code, w.Body.Len())
+	}
+	if other := performRequest(t, router, "GET", "/img/5?w=320", nil).Header().Get("ETag"); other == etag {
+		t.Error("renditions of different widths share an ETag")
+	}
+	if downloads.Load() != 3 {
+		t.Errorf("downloads = %d, want 3", downloads.Load())
+	}
+}
+
+func TestGetImage_CollapsesConcurrentRenders(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 800, 600))); err != nil {
+		t.Fatal(err)
+	}
+	var downloads atomic.Int32
+	release := make(chan struct{})
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		<-release
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/img/:fileId", handler.GetImage)
+
+	mockRepo.getImageFileFunc = func(ctx context.Context, id int64) (*models.StorageFile, error) {
+		return &models.StorageFile{StorageFile: commonModels.StorageFile{ID: id, S3Key: "images/photo.png"}, SourceURL: files.URL + "/images/photo.png"}, nil
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	codes := make([]int, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = performRequest(t, router, "GET", "/img/5?w=320", nil).Code
+		}(i)
+	}
+
+	// Give every request time to join the in-flight render before releasing it
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, code := range codes {
+		if code != http.StatusOK {
+			t.Errorf("request %d status = %d, want %d", i, code, http.StatusOK)
+		}
+	}
+	if got := downloads.Load(); got != 1 {
+		t.Errorf("downloads = %d, want 1", got)
+	}
+}
+
+func TestGetImage_LimitsConcurrentRenders(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 800, 600))); err != nil {
+		t.Fatal(err)
+	}
+	var active, peak atomic.Int32
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/img/:fileId", handler.GetImage)
+
+	mockRepo.getImageFileFunc = func(ctx context.Context, id int64) (*models.StorageFile, error) {
+		key := fmt.Sprintf("images/photo-%d.png", id)
+		return &models.StorageFile{StorageFile: commonModels.StorageFile{ID: id, S3Key: key}, SourceURL: files.URL + "/" + key}, nil
+	}
+
+	// Distinct files, so singleflight cannot collapse the renders
+	const callers = 6
+	var wg sync.WaitGroup
+	codes := make([]int, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = performRequest(t, router, "GET", fmt.Sprintf("/img/%d?w=320", i+1), nil).Code
+		}(i)
+	}
+	wg.Wait()
+
+	for i, code := range codes {
+		if code != http.StatusOK {
+			t.Errorf("request %d status = %d, want %d", i, code, http.StatusOK)
+		}
+	}
+	if got := peak.Load(); got > maxConcurrentRenders {
+		t.Errorf("concurrent downloads = %d, want at most %d", got, maxConcurrentRenders)
+	}
+}
+
+func TestGetImage_FullSizeWithoutMetadata(t *testing.T) {
+	photo := sidewaysPhoto(t, 40, 30)
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(photo)
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/img/:fileId", handler.GetImage)
+
+	mockRepo.getImageFileFunc = func(ctx context.Context, id int64) (*models.StorageFile, error) {
+		return &models.StorageFile{StorageFile: commonModels.StorageFile{ID: id, S3Key: "images/photo.jpg"}, SourceURL: files.URL + "/images/photo.jpg"}, nil
+	}
+
+	// Unsigned requests may ask for the full size
+	w := performRequest(t, router, "GET", "/img/5", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetImage() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if bytes.Contains(w.Body.Bytes(), []byte("Exif")) {
+		t.Error("GetImage() response still contains EXIF data")
+	}
+	config, err := jpeg.DecodeConfig(w.Body)
+	if err != nil || config.Width != 30 || config.Height != 40 {
+		t.Errorf("GetImage() image = %dx%d (%v), want upright 30x40", config.Width, config.Height, err)
+	}
+}
+
+// sidewaysPhoto encodes a width×height JPEG with an EXIF block saying it must
+// be rotated 90° clockwise for display
+func sidewaysPhoto(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Little-endian TIFF with one IFD holding Orientation = 6
+	exif := []byte("Exif\x00\x00II*\x00\x08\x00\x00\x00" +
+		"\x01\x00" + "\x12\x01\x03\x00\x01\x00\x00\x00\x06\x00\x00\x00" + "\x00\x00\x00\x00")
+	segment := append([]byte{0xff, 0xe1, 0, byte(2 + len(exif))}, exif...)
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+func TestGetImage_SignedRenditions(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 800, 600))); err != nil {
+		t.Fatal(err)
+	}
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer files.Close()
+
+	const key = "0123456789abcdef0123456789abcdef"
+	gin.SetMode(gin.TestMode)
+	mockRepo := &mockRepository{}
+	handler := New(mockRepo, WithImageSigningKey(key))
+	router := setupTestRouter(t)
+	router.GET("/img/:fileId", handler.GetImage)
+
+	cover := imaging.Params{Width: 200, Height: 200, Fit: imaging.FitCover}
+	signature := imaging.Sign([]byte(key), 5, cover)
+	// File 7 is a photo, which is never negotiated to the lossless WebP
+	photo := &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 7, S3Key: "images/photo.jpg", MimeType: "image/jpeg"}, SourceURL: files.URL + "/images/photo.jpg"}
+	mockRepo.getImageFileFunc = func(ctx context.Context, id int64) (*models.StorageFile, error) {
+		if id == photo.ID {
+			return photo, nil
+		}
+		return &models.StorageFile{StorageFile: commonModels.StorageFile{ID: id, S3Key: "images/artwork.png", MimeType: "image/png"}, SourceURL: files.URL + "/images/artwork.png"}, nil
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		accept        string
+		want          int
+		contentType   string
+		width, height int
+	}{
+		{"signed cover crop", "/img/5?w=200&h=200&fit=cover&s=" + signature, "", http.StatusOK, "image/jpeg", 200, 200},
+		{"explicit WebP", "/img/5?w=200&h=200&fit=cover&fmt=webp&s=" + signature, "", http.StatusOK, "image/webp", 200, 200},
+		// Clients that accept WebP get it for originals that may be transparent,
+		// without encoding a JPEG as well
+		{"negotiated WebP", "/img/5?w=320", "image/avif,image/webp,*/*", http.StatusOK, "image/webp", 320, 240},
+		{"WebP refused", "/img/5?w=320", "image/webp;q=0,*/*", http.StatusOK, "image/jpeg", 320, 240},
+		{"photo stays JPEG", "/img/7?w=320", "image/avif,image/webp,*/*", http.StatusOK, "image/jpeg", 320, 240},
+		{"explicit WebP photo", "/img/7?w=320&fmt=webp", "", http.StatusOK, "image/webp", 320, 240},
+		{"signature for another file", "/img/6?w=200&h=200&fit=cover&s=" + signature, "", http.StatusForbidden, "", 0, 0},
+		{"signature for other parameters", "/img/5?w=200&h=100&fit=cover&s=" + signature, "", http.StatusForbidden, "", 0, 0},
+		{"malformed signature", "/img/5?w=200&h=200&fit=cover&s=zz", "", http.StatusForbidden, "", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Fatalf("GET %s status = %d, want %d", tt.path, w.Code, tt.want)
+			}
+			if tt.want != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.contentType {
+				t.Errorf("GET %s Content-Type = %s, want %s", tt.path, got, tt.contentType)
+			}
+			config, _, err := image.DecodeConfig(w.Body)
+			if err != nil || config.Width != tt.width || config.Height != tt.height {
+				t.Errorf("GET %s image = %dx%d (%v), want %dx%d", tt.path, config.Width, config.Height, err, tt.width, tt.height)
+			}
+		})
+	}
+}
+
+func TestGetImage_Errors(t *testing.T) {
+	files := httptest.NewServer(http.NotFoundHandler())
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/img/:fileId", handler.GetImage)
+
+	mockRepo.getImageFileFunc = func(ctx context.Context, id int64) (*models.StorageFile, error) {
+		switch id {
+		case 99:
+			return nil, gorm.ErrRecordNotFound
+		case 500:
+			return nil, errors.New("database error")
+		}
+		return &models.StorageFile{StorageFile: commonModels.StorageFile{ID: id, S3Key: "images/missing.png"}, SourceURL: files.URL + "/images/missing.png"}, nil
+	}
+
+	tests := []struct {
+		path string
+		want int
+	}{
+		{"/img/1?w=0", http.StatusBadRequest},
+		{"/img/1?w=abc", http.StatusBadRequest},
+		{"/img/1?w=5000", http.StatusBadRequest},
+		{"/img/1?w=320&h=0", http.StatusBadRequest},
+		{"/img/1?w=320&fit=fill", http.StatusBadRequest},
+		{"/img/1?w=320&fit=cover", http.StatusBadRequest},
+		{"/img/1?w=320&fmt=avif", http.StatusBadRequest},
+		// Renditions other than the variant widths need a signature
+		{"/img/1?w=500", http.StatusForbidden},
+		{"/img/1?w=320&h=320", http.StatusForbidden},
+		{"/img/99?w=320", http.StatusNotFound},
+		{"/img/500?w=320", http.StatusInternalServerError},
+		{"/img/1?w=320", http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		w := performRequest(t, router, "GET", tt.path, nil)
+		if w.Code != tt.want {
+			t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, tt.want)
+		}
+		// An error must not carry the rendition's ETag, or revalidation would keep it
+		if etag := w.Header().Get("ETag"); etag != "" {
+			t.Errorf("GET %s ETag = %s, want none", tt.path, etag)
+		}
+	}
+}
+
+// =============================================================================
+// Gallery Archive Tests
+// =============================================================================
+
+// archivePhoto returns a stored miniature photo served by files under name
+func archivePhoto(files *httptest.Server, id int64, name, caption string) models.MiniatureFile {
+	return models.MiniatureFile{
+		MiniatureFile: commonModels.MiniatureFile{
+			ID:      id,
+			Caption: caption,
+		},
+		File: &models.StorageFile{
+			StorageFile: commonModels.StorageFile{
+				ID:       id,
+				S3Key:    "images/" + name,
+				MimeType: "image/png",
+			},
+			SourceURL:    files.URL + "/images/" + name,
+			ImageDetails: models.ImageDetails{Width: 40, Height: 30},
+		},
+	}
+}
+
+// readArchive opens a ZIP response and decodes its manifest
+func readArchive(t *testing.T, body []byte) (*zip.Reader, archive.Manifest) {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("response is not a ZIP: %v", err)
+	}
+	var manifest archive.Manifest
+	for _, f := range reader.File {
+		if f.Name != archive.ManifestName {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = rc.Close() }()
+		if err := json.NewDecoder(rc).Decode(&manifest); err != nil {
+			t.Fatalf("invalid manifest: %v", err)
+		}
+		return reader, manifest
+	}
+	t.Fatal("archive has no manifest")
+	return nil, manifest
+}
+
+func TestGetMiniatureThemeArchive(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 40, 30))); err != nil {
+		t.Fatal(err)
+	}
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/images/missing.png" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/themes/:id/archive.zip", handler.GetMiniatureThemeArchive)
+
+	red, notes := "#FF0000", "Thinned 2:1"
+	mockRepo.getMiniatureThemeByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureTheme, error) {
+		return &models.MiniatureTheme{
+			MiniatureTheme: commonModels.MiniatureTheme{
+				ID:   id,
+				Name: "Blood Angels",
+			},
+			Miniatures: []models.MiniatureProject{
+				{
+					MiniatureProject: commonModels.MiniatureProject{
+						ID:    1,
+						Title: "Sanguinary Guard",
+						Paints: []models.MiniatureProjectPaint{
+							{Notes: notes, Paint: &models.MiniaturePaint{Name: "Mephiston Red", Manufacturer: "Citadel", ColorHex: &red}},
+						},
+						Techniques: []models.MiniatureProjectTechnique{
+							{Technique: &models.MiniatureTechnique{Name: "Edge Highlighting"}},
+						},
+					},
+					MiniatureFiles: []models.MiniatureFile{
+						archivePhoto(files, 10, "front.png", "Front"),
+						archivePhoto(files, 11, "missing.png", "Back"),
+						archivePhoto(files, 12, "side.png", ""),
+					},
+				},
+				{MiniatureProject: commonModels.MiniatureProject{ID: 2, Title: "Dante"}, MiniatureFiles: []models.MiniatureFile{archivePhoto(files, 20, "dante.png", "Dante")}},
+			},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/themes/3/archive.zip", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Type"); got != "application/zip" {
+		t.Errorf("Content-Type = %s, want application/zip", got)
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="blood-angels.zip"` {
+		t.Errorf("Content-Disposition = %s", got)
+	}
+
+	reader, manifest := readArchive(t, w.Body.Bytes())
+	var names []string
+	for _, f := range reader.File {
+		names = append(names, f.Name)
+	}
+	// Photos in display order, the unavailable one left out, manifest last
+	want := []string{
+		"01-sanguinary-guard/01-front.jpg",
+		"01-sanguinary-guard/03-photo.jpg",
+		"02-dante/01-dante.jpg",
+		archive.ManifestName,
+	}
+	if !slices.Equal(names, want) {
+		t.Errorf("entries = %v, want %v", names, want)
+	}
+
+	rc, err := reader.File[0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := jpeg.DecodeConfig(rc)
+	_ = rc.Close()
+	if err != nil || config.Width != 40 || config.Height != 30 {
+		t.Errorf("photo = %dx%d (%v), want full-size JPEG", config.Width, config.Height, err)
+	}
+
+	if manifest.Title != "Blood Angels" || len(manifest.Miniatures) != 2 {
+		t.Fatalf("manifest = %+v, want both miniatures of the theme", manifest)
+	}
+	guard := manifest.Miniatures[0]
+	if len(guard.Paints) != 1 || guard.Paints[0].ColorHex != red || guard.Paints[0].Notes != notes {
+		t.Errorf("paints = %+v, want Mephiston Red with notes", guard.Paints)
+	}
+	if len(guard.Techniques) != 1 || guard.Techniques[0].Name != "Edge Highlighting" {
+		t.Errorf("techniques = %+v", guard.Techniques)
+	}
+	if len(guard.Photos) != 3 || guard.Photos[0].File != want[0] || guard.Photos[1].File != "" || guard.Photos[1].Caption != "Back" {
+		t.Errorf("photos = %+v, want the missing photo listed without a file", guard.Photos)
+	}
+}
+
+func TestGetMiniatureArchive(t *testing.T) {
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/projects/:id/archive.zip", handler.GetMiniatureArchive)
+
+	mockRepo.getMiniatureProjectByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureProject, error) {
+		switch id {
+		case 99:
+			return nil, gorm.ErrRecordNotFound
+		case 500:
+			return nil, errors.New("database error")
+		}
+		// Only images are included
+		return &models.MiniatureProject{
+			MiniatureProject: commonModels.MiniatureProject{
+				ID:    id,
+				Title: "Ærøskøbing",
+			},
+			MiniatureFiles: []models.MiniatureFile{
+				{MiniatureFile: commonModels.MiniatureFile{ID: 1}, File: &models.StorageFile{StorageFile: commonModels.StorageFile{ID: 1, MimeType: "application/pdf"}}},
+			},
+		}, nil
+	}
+
+	w := performRequest(t, router, "GET", "/miniatures/projects/7/archive.zip", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="aeroskobing.zip"` {
+		t.Errorf("Content-Disposition = %s", got)
+	}
+	reader, manifest := readArchive(t, w.Body.Bytes())
+	if len(reader.File) != 1 || len(manifest.Miniatures) != 1 || len(manifest.Miniatures[0].Photos) != 0 {
+		t.Errorf("archive = %d entries, manifest %+v, want only the manifest", len(reader.File), manifest)
+	}
+
+	for path, want := range map[string]int{
+		"/miniatures/projects/99/archive.zip":  http.StatusNotFound,
+		"/miniatures/projects/500/archive.zip": http.StatusInternalServerError,
+	} {
+		if w := performRequest(t, router, "GET", path, nil); w.Code != want {
+			t.Errorf("GET %s status = %d, want %d", path, w.Code, want)
+		}
+	}
+}
+
+func TestGetMiniatureArchive_Cancelled(t *testing.T) {
+	var downloads atomic.Int32
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		http.NotFound(w, r)
+	}))
+	defer files.Close()
+
+	handler, mockRepo := setupTestHandler(t)
+	router := setupTestRouter(t)
+	router.GET("/miniatures/projects/:id/archive.zip", handler.GetMiniatureArchive)
+
+	mockRepo.getMiniatureProjectByIDFunc = func(ctx context.Context, id int64) (*models.MiniatureProject, error) {
+		return &models.MiniatureProject{
+			MiniatureProject: commonModels.MiniatureProject{
+				ID:    id,
+				Title: "Dante",
+			},
+			MiniatureFiles: []models.MiniatureFile{
+				archivePhoto(files, 1, "a.png", ""), archivePhoto(files, 2, "b.png", ""),
+			},
+		}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/miniatures/projects/1/archive.zip", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// Nothing is downloaded and the archive is left without its directory
+	if downloads.Load() != 0 {
+		t.Errorf("downloads = %d, want 0", downloads.Load())
+	}
+	if _, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len())); err == nil {
+		t.Error("cancelled download produced a complete archive")
+	}
+}
+
 // =============================================================================
 // Context Propagation Tests
 // =============================================================================
@@ -919,6 +3369,17 @@ func TestInvalidIDFormats(t *testing.T) {
 	router.GET("/projects/:id", handler.GetProjectByID)
 	router.GET("/miniatures/:id", handler.GetMiniatureByID)
 	router.GET("/miniatures/themes/:id", handler.GetMiniatureThemeByID)
+	router.GET("/skills/:id/projects", handler.GetSkillProjects)
+	router.GET("/meta/projects/:id", handler.GetProjectMeta)
+	router.GET("/meta/miniatures/:id", handler.GetMiniatureMeta)
+	router.GET("/meta/themes/:id", handler.GetThemeMeta)
+	router.GET("/projects/:id/navigation", handler.GetProjectNavigation)
+	router.GET("/miniatures/projects/:id/navigation", handler.GetMiniatureNavigation)
+	router.GET("/miniatures/paints/:id", handler.GetPaintMiniatures)
+	router.GET("/miniatures/techniques/:id", handler.GetTechniqueMiniatures)
+	router.GET("/img/:fileId", handler.GetImage)
+	router.GET("/miniatures/themes/:id/archive.zip", handler.GetMiniatureThemeArchive)
+	router.GET("/miniatures/projects/:id/archive.zip", handler.GetMiniatureArchive)
 
 	// Note: Negative IDs are parseable by strconv.ParseInt, so they pass validation
 	// and get a "not found" from the repository. Only non-numeric strings fail.
@@ -937,6 +3398,17 @@ func TestInvalidIDFormats(t *testing.T) {
 		{"miniature with special chars", "/miniatures/", "!@#"},
 		{"miniature theme with string ID", "/miniatures/themes/", "abc"},
 		{"miniature theme with float ID", "/miniatures/themes/", "1.5"},
+		{"skill projects with string ID", "/skills/", "abc/projects"},
+		{"project meta with string ID", "/meta/projects/", "abc"},
+		{"miniature meta with float ID", "/meta/miniatures/", "1.5"},
+		{"theme meta with string ID", "/meta/themes/", "abc"},
+		{"project navigation with string ID", "/projects/", "abc/navigation"},
+		{"miniature navigation with float ID", "/miniatures/projects/", "1.5/navigation"},
+		{"paint with string ID", "/miniatures/paints/", "abc"},
+		{"technique with float ID", "/miniatures/techniques/", "1.5"},
+		{"image with string ID", "/img/", "abc"},
+		{"theme archive with string ID", "/miniatures/themes/", "abc/archive.zip"},
+		{"miniature archive with float ID", "/miniatures/projects/", "1.5/archive.zip"},
 	}
 
 	for _, tt := range tests {
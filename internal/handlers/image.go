@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
+	"github.com/GunarsK-portfolio/public-api/internal/middleware"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// Rendered images are kept in process unless a disk cache is configured.
+// Stored files never change under a key, so the key of a rendition includes
+// the storage key of its original and can be kept for long.
+const (
+	imageCacheEntries = 128
+	imageCacheTTL     = 30 * 24 * time.Hour
+	imageFetchTimeout = 10 * time.Second
+	maxImageDimension = 4096
+
+	// Originals may be up to 40 megapixels and every render holds several
+	// full-size copies, so only a few renders run at once and the rest queue
+	maxConcurrentRenders = 2
+	renderQueueTimeout   = 10 * time.Second
+
+	// Versioned so that renditions cached before a change to rendering are redrawn
+	imageCacheKeyPrefix = "img:v2:"
+)
+
+// GetImage godoc
+// @Summary Get a resized image
+// @Description Get a rendition of a published image, upright and without EXIF or XMP metadata. Unsigned
+// @Description requests may only ask for the full size (no w or h) or one of the variant widths (320, 768
+// @Description or 1600) listed in the variants of every image in other responses. Any other combination of
+// @Description w, h and fit needs a signature s (see README). Images are never enlarged. Without fmt, JPEG is
+// @Description served, except that PNG, GIF and WebP originals, which may be transparent, are served as
+// @Description lossless WebP to clients that accept it. The ETag identifies the rendition, so revalidation
+// @Description never renders the image.
+// @Tags images
+// @Produce image/jpeg,image/webp
+// @Param fileId path int true "Storage file ID"
+// @Param w query int false "Maximum width, or box width with fit=cover (1-4096); full size without w and h"
+// @Param h query int false "Maximum height, or box height with fit=cover (1-4096)"
+// @Param fit query string false "inside (default) or cover, which crops to exactly w×h" Enums(inside, cover)
+// @Param fmt query string false "Output format; negotiated from Accept when omitted" Enums(jpeg, webp)
+// @Param s query string false "Signature of the fileId, w, h and fit parameters"
+// @Success 200 {file} binary
+// @Success 304 "Not Modified"
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 502 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /img/{fileId} [get]
+func (h *Handler) GetImage(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("fileId"), 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+	params, format, ok := parseImageRequest(c)
+	if !ok {
+		return
+	}
+	if !h.imageAllowed(c, id, params) {
+		commonHandlers.RespondError(c, http.StatusForbidden, "invalid signature")
+		return
+	}
+
+	file, err := h.repo.GetImageFile(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "image not found", "failed to fetch image")
+		return
+	}
+
+	if format == "" {
+		format = negotiateFormat(c, file)
+	}
+
+	// The ETag is only sent with the rendition itself, never with an error
+	etag := renditionETag(renditionKey(file, params, format))
+	if middleware.MatchesETag(c.GetHeader("If-None-Match"), etag) {
+		c.Header("ETag", etag)
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	data, err := h.renderImage(c.Request.Context(), file, params, format)
+	switch {
+	case errors.Is(err, errImageUnavailable):
+		commonHandlers.LogAndRespondError(c, http.StatusBadGateway, err, "failed to fetch image")
+		return
+	case errors.Is(err, errImageBusy):
+		commonHandlers.LogAndRespondError(c, http.StatusServiceUnavailable, err, "too many images being resized")
+		return
+	case err != nil:
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to resize image")
+		return
+	}
+	c.Header("ETag", etag)
+	c.Data(http.StatusOK, "image/"+format, data)
+}
+
+var (
+	// errImageUnavailable marks failures to download or decode the original
+	errImageUnavailable = errors.New("image unavailable")
+	// errImageBusy marks renders that waited too long for a free slot
+	errImageBusy = errors.New("image renderer busy")
+)
+
+// renditionKey identifies the rendition of file described by params in format.
+// Stored files never change under a storage key, so neither does the rendition.
+func renditionKey(file *models.StorageFile, params imaging.Params, format string) string {
+	return fmt.Sprintf("%s%s:%dx%d:%s:%s", imageCacheKeyPrefix, file.S3Key, params.Width, params.Height, params.Fit, format)
+}
+
+// renditionETag derives a strong ETag from a rendition key, so a conditional
+// request can be answered without rendering
+func renditionETag(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// renderImage returns the rendition of file described by params in format,
+// from the image cache when it was rendered before. Concurrent misses for the
+// same rendition download and encode the original once, and at most
+// maxConcurrentRenders renditions are rendered at a time.
+func (h *Handler) renderImage(ctx context.Context, file *models.StorageFile, params imaging.Params, format string) ([]byte, error) {
+	key := renditionKey(file, params, format)
+	if cached, err := h.images.Get(ctx, key); err == nil {
+		return cached, nil
+	}
+
+	// The shared render must not be cancelled when the first caller goes away,
+	// since other callers may be waiting on the same result
+	result := h.renders.DoChan(key, func() (any, error) {
+		renderCtx := context.WithoutCancel(ctx)
+		slotCtx, cancel := context.WithTimeout(renderCtx, renderQueueTimeout)
+		defer cancel()
+		if err := h.renderSlots.Acquire(slotCtx, 1); err != nil {
+			return nil, errImageBusy
+		}
+		defer h.renderSlots.Release(1)
+
+		original, err := h.fetchImage(renderCtx, file.SourceURL)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errImageUnavailable, err)
+		}
+		data, err := encodeImage(imaging.Transform(original, params), format)
+		if err != nil {
+			return nil, err
+		}
+		_ = h.images.Set(renderCtx, key, data, imageCacheTTL)
+		return data, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
+
+// parseImageRequest reads the rendition parameters and output format,
+// responding with 400 when one is invalid. The format is empty when it is
+// left to negotiateFormat.
+func parseImageRequest(c *gin.Context) (imaging.Params, string, bool) {
+	var params imaging.Params
+	var ok bool
+	if params.Width, ok = parseDimension(c.Query("w")); !ok {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid width")
+		return params, "", false
+	}
+	if params.Height, ok = parseDimension(c.Query("h")); !ok {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid height")
+		return params, "", false
+	}
+	params.Fit = c.DefaultQuery("fit", imaging.FitInside)
+	switch {
+	case params.Fit == imaging.FitCover && (params.Width == 0 || params.Height == 0):
+		commonHandlers.RespondError(c, http.StatusBadRequest, "fit=cover needs both width and height")
+		return params, "", false
+	case params.Fit != imaging.FitInside && params.Fit != imaging.FitCover:
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid fit")
+		return params, "", false
+	}
+
+	format := c.Query("fmt")
+	switch format {
+	case imaging.FormatJPEG, imaging.FormatWebP, "":
+	default:
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid format")
+		return params, "", false
+	}
+	return params, format, true
+}
+
+// parseDimension parses an optional width or height, returning 0 when absent
+func parseDimension(value string) (int, bool) {
+	if value == "" {
+		return 0, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 || n > maxImageDimension {
+		return 0, false
+	}
+	return n, true
+}
+
+// imageAllowed reports whether the rendition may be served: the full size and
+// the variant widths always may, anything else needs a valid signature
+func (h *Handler) imageAllowed(c *gin.Context, id int64, params imaging.Params) bool {
+	if params.Height == 0 && params.Fit == imaging.FitInside && (params.Width == 0 || imaging.IsVariantWidth(params.Width)) {
+		return true
+	}
+	return len(h.imageSigningKey) > 0 && imaging.Verify(h.imageSigningKey, id, params, c.Query("s"))
+}
+
+// negotiateFormat picks the output format when fmt is omitted. The WebP
+// encoder is lossless, which makes photos larger than JPEG, so JPEG is the
+// default and WebP is only chosen for originals that may be transparent,
+// whose transparency JPEG would lose.
+func negotiateFormat(c *gin.Context, file *models.StorageFile) string {
+	if !imaging.MayBeTransparent(file.MimeType) {
+		return imaging.FormatJPEG
+	}
+	// The response depends on Accept, so shared caches must key on it
+	c.Header("Vary", "Accept")
+	if acceptsWebP(c.GetHeader("Accept")) {
+		return imaging.FormatWebP
+	}
+	return imaging.FormatJPEG
+}
+
+// acceptsWebP reports whether an Accept header lists image/webp with a non-zero quality
+func acceptsWebP(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, parameters, _ := strings.Cut(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(mediaType), "image/webp") {
+			continue
+		}
+		for _, parameter := range strings.Split(parameters, ";") {
+			name, value, _ := strings.Cut(parameter, "=")
+			if strings.TrimSpace(name) == "q" {
+				if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil && q == 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// encodeImage encodes img in format
+func encodeImage(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	encode := imaging.EncodeJPEG
+	if format == imaging.FormatWebP {
+		encode = imaging.EncodeWebP
+	}
+	if err := encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (h *Handler) fetchImage(ctx context.Context, imageURL string) (image.Image, error) {
+	ctx, cancel := context.WithTimeout(ctx, imageFetchTimeout)
+	defer cancel()
+	img, _, err := imaging.FetchImage(ctx, h.imageClient, imageURL)
+	return img, err
+}
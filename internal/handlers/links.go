@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+// Public website paths of content pages, appended to the site URL
+const (
+	projectPagePath   = "/projects/%d"
+	miniaturePagePath = "/miniatures/%d"
+	themePagePath     = "/miniatures/themes/%d"
+)
+
+// projectOGImagePath is the API path of a project's generated preview image
+const projectOGImagePath = "/og/projects/%d.png"
+
+// requireSiteURL responds with 404 and returns false when no public site URL
+// is configured. Absolute links are never derived from request headers, since
+// responses are publicly cacheable and the Host header is client-controlled.
+func (h *Handler) requireSiteURL(c *gin.Context) bool {
+	if h.siteURL == "" {
+		commonHandlers.RespondError(c, http.StatusNotFound, "site url not configured")
+		return false
+	}
+	return true
+}
+
+// canonicalSlug looks up the slug of id in the map returned by load.
+// On failure it responds with 500 and internalMsg and returns false.
+func canonicalSlug(c *gin.Context, load func(context.Context) (map[int64]string, error), id int64, internalMsg string) (string, bool) {
+	slugs, err := load(c.Request.Context())
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, internalMsg)
+		return "", false
+	}
+	return slugs[id], true
+}
+
+// redirectToSlug sends a permanent redirect to the same route under the
+// canonical slug when the request used an older form of it, and reports
+// whether it did. Routes by ID have no slug and are never redirected. The
+// location is a path, never built from headers.
+func redirectToSlug(c *gin.Context, canonical string) bool {
+	requested := c.Param("slug")
+	if requested == "" || canonical == "" || requested == canonical {
+		return false
+	}
+	location := path.Join(path.Dir(c.Request.URL.Path), canonical)
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+	c.Redirect(http.StatusMovedPermanently, location)
+	return true
+}
@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// maxMetaDescription is the rune budget of preview descriptions; longer
+// text is cut at a word boundary, since social cards truncate around here
+const maxMetaDescription = 200
+
+// metaShell is served to crawlers that do not run JavaScript. Browsers that
+// land on it are sent on to the canonical page, which exists only when a site
+// URL is configured; without one there is nowhere safe to send them.
+var metaShell = template.Must(template.New("meta").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<meta name="description" content="{{.Description}}">
+{{- if .URL}}
+<link rel="canonical" href="{{.URL}}">
+{{- end}}
+<meta property="og:type" content="website">
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Description}}">
+{{- if .URL}}
+<meta property="og:url" content="{{.URL}}">
+{{- end}}
+{{- if .Image}}
+<meta property="og:image" content="{{.Image}}">
+<meta name="twitter:card" content="summary_large_image">
+<meta name="twitter:image" content="{{.Image}}">
+{{- else}}
+<meta name="twitter:card" content="summary">
+{{- end}}
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{- if .URL}}
+<meta http-equiv="refresh" content="0; url={{.URL}}">
+{{- end}}
+</head>
+<body>
+{{- if .URL}}
+<p><a href="{{.URL}}">{{.Title}}</a></p>
+{{- else}}
+<p>{{.Title}}</p>
+{{- end}}
+</body>
+</html>
+`))
+
+// GetProjectMeta godoc
+// @Summary Get project preview metadata
+// @Description Get the title, description, canonical URL and image used for social previews of a project page.
+// @Description The image is the generated /og/projects/{id}.png card.
+// @Description With format=html an HTML document with og:* and twitter:* tags is returned for crawlers.
+// @Tags meta
+// @Produce json,html
+// @Param id path int true "Project ID"
+// @Param format query string false "Response format (json, html)"
+// @Success 200 {object} models.PageMeta
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meta/projects/{id} [get]
+func (h *Handler) GetProjectMeta(c *gin.Context) {
+	id, ok := parseMetaRequest(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.repo.GetProjectByID(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
+		return
+	}
+
+	// The generated card includes the project image when there is one
+	meta := models.PageMeta{
+		Title:       project.Title,
+		Description: summarize(project.Description),
+		URL:         h.pageURL(projectPagePath, project.ID),
+	}
+	if h.apiURL != "" {
+		meta.Image = h.apiURL + fmt.Sprintf(projectOGImagePath, project.ID)
+	}
+	respondMeta(c, meta)
+}
+
+// GetMiniatureMeta godoc
+// @Summary Get miniature preview metadata
+// @Description Get the title, description, canonical URL and image used for social previews of a miniature page.
+// @Description With format=html an HTML document with og:* and twitter:* tags is returned for crawlers.
+// @Tags meta
+// @Produce json,html
+// @Param id path int true "Miniature project ID"
+// @Param format query string false "Response format (json, html)"
+// @Success 200 {object} models.PageMeta
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meta/miniatures/{id} [get]
+func (h *Handler) GetMiniatureMeta(c *gin.Context) {
+	id, ok := parseMetaRequest(c)
+	if !ok {
+		return
+	}
+
+	miniature, err := h.repo.GetMiniatureProjectByID(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature project")
+		return
+	}
+
+	meta := models.PageMeta{
+		Title:       miniature.Title,
+		Description: summarize(miniature.Description),
+		URL:         h.pageURL(miniaturePagePath, miniature.ID),
+	}
+	if len(miniature.Images) > 0 {
+		meta.Image = miniature.Images[0].URL
+	}
+	respondMeta(c, meta)
+}
+
+// GetThemeMeta godoc
+// @Summary Get miniature theme preview metadata
+// @Description Get the title, description, canonical URL and image used for social previews of a theme page.
+// @Description The cover image is used, falling back to the first miniature image in the theme.
+// @Description With format=html an HTML document with og:* and twitter:* tags is returned for crawlers.
+// @Tags meta
+// @Produce json,html
+// @Param id path int true "Theme ID"
+// @Param format query string false "Response format (json, html)"
+// @Success 200 {object} models.PageMeta
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meta/themes/{id} [get]
+func (h *Handler) GetThemeMeta(c *gin.Context) {
+	id, ok := parseMetaRequest(c)
+	if !ok {
+		return
+	}
+
+	theme, err := h.repo.GetMiniatureThemeByID(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to fetch miniature theme")
+		return
+	}
+
+	meta := models.PageMeta{
+		Title:       theme.Name,
+		Description: summarize(theme.Description),
+		URL:         h.pageURL(themePagePath, theme.ID),
+	}
+	if theme.CoverImageFile != nil {
+		meta.Image = theme.CoverImageFile.URL
+	}
+	for _, miniature := range theme.Miniatures {
+		if meta.Image != "" {
+			break
+		}
+		if len(miniature.Images) > 0 {
+			meta.Image = miniature.Images[0].URL
+		}
+	}
+	respondMeta(c, meta)
+}
+
+// pageURL is the canonical website address of a content page, or "" when no
+// site URL is configured
+func (h *Handler) pageURL(path string, id int64) string {
+	if h.siteURL == "" {
+		return ""
+	}
+	return h.siteURL + fmt.Sprintf(path, id)
+}
+
+// parseMetaRequest validates the id and format parameters before any lookup,
+// responding with 400 and returning false when either is invalid
+func parseMetaRequest(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return 0, false
+	}
+	switch c.Query("format") {
+	case "", "json", "html":
+		return id, true
+	default:
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid format")
+		return 0, false
+	}
+}
+
+// respondMeta writes the metadata as JSON or, with format=html, as an HTML shell
+func respondMeta(c *gin.Context, meta models.PageMeta) {
+	if c.Query("format") != "html" {
+		c.JSON(http.StatusOK, meta)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := metaShell.Execute(&body, meta); err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to render metadata")
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", body.Bytes())
+}
+
+// summarize collapses whitespace and shortens text to maxMetaDescription runes,
+// cutting at the last word boundary and marking the cut with an ellipsis
+func summarize(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	if len(runes) <= maxMetaDescription {
+		return text
+	}
+
+	cut := string(runes[:maxMetaDescription-1])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:-") + "…"
+}
@@ -1,30 +1,65 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
 	"github.com/gin-gonic/gin"
 )
 
 // GetMiniatures godoc
 // @Summary Get all miniature projects
-// @Description Get list of all miniature painting projects with images
+// @Description Get list of miniature painting projects with images.
+// @Description Returns a plain array unless page or limit is given, in which case a
+// @Description models.PaginatedResponse envelope with data and pagination is returned instead.
 // @Tags miniatures
 // @Produce json
+// @Param page query int false "Page number (1-based)"
+// @Param limit query int false "Page size (max 100)"
+// @Param themeId query int false "Filter by theme ID"
+// @Param scale query string false "Filter by scale"
+// @Param manufacturer query string false "Filter by manufacturer"
+// @Param difficulty query string false "Filter by difficulty"
+// @Param techniqueId query int false "Filter by technique ID"
+// @Param paintId query int false "Filter by paint ID"
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (title, scale, difficulty, displayOrder, completedDate, createdAt, updatedAt)"
 // @Success 200 {array} models.MiniatureProject
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /miniatures [get]
 func (h *Handler) GetMiniatures(c *gin.Context) {
-	var projects []models.MiniatureProject
-	projects, err := h.repo.GetAllMiniatureProjects(c.Request.Context())
+	pagination, paged, err := parsePagination(c)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter, err := parseMiniatureFilter(c)
 	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.Pagination = pagination
+
+	projects, total, err := h.repo.ListMiniatureProjects(c.Request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSort) {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid sort field")
+			return
+		}
 		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature projects")
 		return
 	}
-	c.JSON(http.StatusOK, projects)
+
+	if !paged {
+		c.JSON(http.StatusOK, projects)
+		return
+	}
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(projects, pagination.Page, pagination.Limit, total))
 }
 
 // GetMiniatureByID godoc
@@ -33,7 +68,7 @@ func (h *Handler) GetMiniatures(c *gin.Context) {
 // @Tags miniatures
 // @Produce json
 // @Param id path int true "Miniature Project ID"
-// @Success 200 {object} models.MiniatureProject
+// @Success 200 {object} models.MiniatureDetail
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
@@ -52,7 +87,39 @@ func (h *Handler) GetMiniatureByID(c *gin.Context) {
 		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature project")
 		return
 	}
-	c.JSON(http.StatusOK, project)
+	h.respondMiniature(c, project)
+}
+
+// GetMiniatureBySlug godoc
+// @Summary Get miniature project by slug
+// @Description Get detailed information about a miniature project by its canonical slug, derived from the
+// @Description title. Older forms of the slug (a bare ID, "<id>-<title>" or a duplicate suffix no longer
+// @Description needed) are answered with a 301 redirect to the canonical slug.
+// @Tags miniatures
+// @Produce json
+// @Param slug path string true "Miniature project slug, e.g. space-marine"
+// @Success 200 {object} models.MiniatureDetail
+// @Success 301 "Redirect to the canonical slug"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/projects/by-slug/{slug} [get]
+func (h *Handler) GetMiniatureBySlug(c *gin.Context) {
+	project, err := h.repo.GetMiniatureProjectBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature project")
+		return
+	}
+	h.respondMiniature(c, project)
+}
+
+// respondMiniature writes a miniature project together with its canonical slug,
+// redirecting requests by an older form of the slug to it
+func (h *Handler) respondMiniature(c *gin.Context, project *models.MiniatureProject) {
+	slug, ok := canonicalSlug(c, h.repo.GetMiniatureSlugs, project.ID, "failed to fetch miniature project")
+	if !ok || redirectToSlug(c, slug) {
+		return
+	}
+	c.JSON(http.StatusOK, models.MiniatureDetail{MiniatureProject: *project, Slug: slug})
 }
 
 // GetMiniatureThemes godoc
@@ -79,7 +146,7 @@ func (h *Handler) GetMiniatureThemes(c *gin.Context) {
 // @Tags miniatures
 // @Produce json
 // @Param id path int true "Miniature Theme ID"
-// @Success 200 {object} models.MiniatureTheme
+// @Success 200 {object} models.ThemeDetail
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
@@ -97,5 +164,37 @@ func (h *Handler) GetMiniatureThemeByID(c *gin.Context) {
 		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to fetch miniature theme")
 		return
 	}
-	c.JSON(http.StatusOK, theme)
+	h.respondTheme(c, theme)
+}
+
+// GetMiniatureThemeBySlug godoc
+// @Summary Get miniature theme by slug
+// @Description Get detailed information about a miniature theme and its projects by the theme's canonical
+// @Description slug, derived from its name. Older forms of the slug (a bare ID, "<id>-<name>" or a duplicate
+// @Description suffix no longer needed) are answered with a 301 redirect to the canonical slug.
+// @Tags miniatures
+// @Produce json
+// @Param slug path string true "Miniature theme slug, e.g. warhammer-40k"
+// @Success 200 {object} models.ThemeDetail
+// @Success 301 "Redirect to the canonical slug"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/themes/by-slug/{slug} [get]
+func (h *Handler) GetMiniatureThemeBySlug(c *gin.Context) {
+	theme, err := h.repo.GetMiniatureThemeBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to fetch miniature theme")
+		return
+	}
+	h.respondTheme(c, theme)
+}
+
+// respondTheme writes a miniature theme together with its canonical slug,
+// redirecting requests by an older form of the slug to it
+func (h *Handler) respondTheme(c *gin.Context, theme *models.MiniatureTheme) {
+	slug, ok := canonicalSlug(c, h.repo.GetThemeSlugs, theme.ID, "failed to fetch miniature theme")
+	if !ok || redirectToSlug(c, slug) {
+		return
+	}
+	c.JSON(http.StatusOK, models.ThemeDetail{MiniatureTheme: *theme, Slug: slug})
 }
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultRelatedLimit = 4
+	maxRelatedLimit     = 12
+)
+
+// GetProjectNavigation godoc
+// @Summary Get project navigation
+// @Description Get the previous and next projects in list order and the projects sharing the most technologies.
+// @Description Pass the same sort and filter parameters as GET /projects to navigate within that list.
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param related query int false "Maximum related projects (default 4, max 12, 0 to skip)"
+// @Param sort query string false "Sort fields as for GET /projects"
+// @Param category query string false "Filter by category"
+// @Param featured query bool false "Filter by featured flag"
+// @Param isOngoing query bool false "Filter by ongoing flag"
+// @Param technology query string false "Filter by technology (skill ID or name)"
+// @Success 200 {object} models.Navigation
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /projects/{id}/navigation [get]
+func (h *Handler) GetProjectNavigation(c *gin.Context) {
+	id, relatedLimit, ok := parseNavigationRequest(c)
+	if !ok {
+		return
+	}
+
+	filter, err := parseProjectFilter(c)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	nav, err := h.repo.GetProjectNavigation(c.Request.Context(), id, filter, relatedLimit)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSort) {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid sort field")
+			return
+		}
+		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project navigation")
+		return
+	}
+	respondNavigation(c, nav)
+}
+
+// GetMiniatureNavigation godoc
+// @Summary Get miniature project navigation
+// @Description Get the previous and next miniatures in list order and the miniatures sharing the most
+// @Description theme, techniques and paints. Pass the same sort and filter parameters as GET /miniatures
+// @Description to navigate within that list.
+// @Tags miniatures
+// @Produce json
+// @Param id path int true "Miniature project ID"
+// @Param related query int false "Maximum related miniatures (default 4, max 12, 0 to skip)"
+// @Param sort query string false "Sort fields as for GET /miniatures"
+// @Param themeId query int false "Filter by theme ID"
+// @Param scale query string false "Filter by scale"
+// @Param manufacturer query string false "Filter by manufacturer"
+// @Param difficulty query string false "Filter by difficulty"
+// @Param techniqueId query int false "Filter by technique ID"
+// @Param paintId query int false "Filter by paint ID"
+// @Success 200 {object} models.Navigation
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /miniatures/projects/{id}/navigation [get]
+func (h *Handler) GetMiniatureNavigation(c *gin.Context) {
+	id, relatedLimit, ok := parseNavigationRequest(c)
+	if !ok {
+		return
+	}
+
+	filter, err := parseMiniatureFilter(c)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	nav, err := h.repo.GetMiniatureNavigation(c.Request.Context(), id, filter, relatedLimit)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSort) {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid sort field")
+			return
+		}
+		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature navigation")
+		return
+	}
+	respondNavigation(c, nav)
+}
+
+// parseNavigationRequest validates the id path parameter and the related limit,
+// responding with 400 and returning false when either is invalid
+func parseNavigationRequest(c *gin.Context) (int64, int, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return 0, 0, false
+	}
+
+	limit := defaultRelatedLimit
+	if raw, ok := c.GetQuery("related"); ok {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 || limit > maxRelatedLimit {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid related")
+			return 0, 0, false
+		}
+	}
+	return id, limit, true
+}
+
+func respondNavigation(c *gin.Context, nav *models.Navigation) {
+	c.JSON(http.StatusOK, nav)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"image"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/portfolio-common/logger"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
+	"github.com/GunarsK-portfolio/public-api/internal/ogimage"
+	"github.com/gin-gonic/gin"
+)
+
+// Rendered preview images are kept in process; the key includes a digest of
+// the card content, so edits produce a new image without explicit invalidation
+const (
+	ogImageCacheEntries = 128
+	ogImageCacheTTL     = 24 * time.Hour
+	ogImageFetchTimeout = 5 * time.Second
+)
+
+// GetProjectOGImage godoc
+// @Summary Get project preview image
+// @Description Get a 1200×630 PNG Open Graph image with the project title, technology badges and project image.
+// @Description Projects without an image get a text-only card.
+// @Tags meta
+// @Produce png
+// @Param id path int true "Project ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /og/projects/{id}.png [get]
+func (h *Handler) GetProjectOGImage(c *gin.Context) {
+	// Gin cannot match a parameter followed by a literal suffix, so the route
+	// captures the whole "<id>.png" segment
+	idStr, ok := strings.CutSuffix(c.Param("file"), ".png")
+	if !ok {
+		commonHandlers.RespondError(c, http.StatusNotFound, "not found")
+		return
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	project, err := h.repo.GetProjectByID(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
+		return
+	}
+
+	card := ogimage.Card{
+		Label:  project.Category,
+		Title:  project.Title,
+		Footer: siteHost(h.siteURL),
+	}
+	for _, technology := range project.Technologies {
+		card.Badges = append(card.Badges, ogimage.Badge{Label: technology.Skill, Group: technology.Type})
+	}
+	imageURL := ""
+	if project.ImageFile != nil {
+		imageURL = project.ImageFile.SourceURL
+	}
+
+	key, err := ogImageKey(project.ID, card, imageURL)
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to render preview image")
+		return
+	}
+	if cached, err := h.ogImages.Get(c.Request.Context(), key); err == nil {
+		c.Data(http.StatusOK, "image/png", cached)
+		return
+	}
+
+	// A missing picture degrades to a text-only card rather than failing the
+	// request, but such a card is not cached, here or by clients and CDNs, so
+	// the picture is retried
+	complete := true
+	if imageURL != "" {
+		card.Image, err = h.fetchOGImage(c.Request.Context(), imageURL)
+		if err != nil {
+			logger.GetLogger(c).Warn("Preview image download failed, rendering without it",
+				"project_id", project.ID, "error", err)
+			complete = false
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := ogimage.Render(&buf, card); err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to render preview image")
+		return
+	}
+	if complete {
+		_ = h.ogImages.Set(c.Request.Context(), key, buf.Bytes(), ogImageCacheTTL)
+	} else {
+		c.Header("Cache-Control", "no-store")
+	}
+	c.Data(http.StatusOK, "image/png", buf.Bytes())
+}
+
+func (h *Handler) fetchOGImage(ctx context.Context, imageURL string) (image.Image, error) {
+	ctx, cancel := context.WithTimeout(ctx, ogImageFetchTimeout)
+	defer cancel()
+	img, _, err := imaging.FetchImage(ctx, h.imageClient, imageURL)
+	return img, err
+}
+
+// ogImageKey identifies a rendered card by project and content digest
+func ogImageKey(id int64, card ogimage.Card, imageURL string) (string, error) {
+	encoded, err := json.Marshal(struct {
+		Card     ogimage.Card
+		ImageURL string
+	}{card, imageURL})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return "og:project:" + strconv.FormatInt(id, 10) + ":" + hex.EncodeToString(sum[:16]), nil
+}
+
+// siteHost returns the host of an origin URL for display
+func siteHost(origin string) string {
+	parsed, err := url.Parse(origin)
+	if err != nil {
+		return ""
+	}
+	return parsed.Host
+}
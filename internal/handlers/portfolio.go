@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/portfolio-common/logger"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// portfolioBundleTimeout caps the bundle when the request context has no earlier deadline
+const portfolioBundleTimeout = 10 * time.Second
+
+// GetPortfolio godoc
+// @Summary Get portfolio bundle
+// @Description Get profile, experience, certifications, skills and projects in one document.
+// @Description Sections are loaded concurrently; a section that fails is left empty and
+// @Description reported in the errors map instead of failing the whole response.
+// @Tags portfolio
+// @Produce json
+// @Success 200 {object} models.PortfolioBundle
+// @Failure 500 {object} map[string]string
+// @Router /portfolio [get]
+func (h *Handler) GetPortfolio(c *gin.Context) {
+	// Every section shares one deadline derived from the request context
+	ctx, cancel := context.WithTimeout(c.Request.Context(), portfolioBundleTimeout)
+	defer cancel()
+
+	var (
+		bundle models.PortfolioBundle
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+	)
+	sectionErrors := make(map[string]string)
+	sections := 0
+
+	load := func(section, notFoundMsg, internalMsg string, fetch func() error) {
+		sections++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := fetch()
+			if err == nil {
+				return
+			}
+
+			message := internalMsg
+			if errors.Is(err, gorm.ErrRecordNotFound) && notFoundMsg != "" {
+				message = notFoundMsg
+			} else {
+				logger.GetLogger(c).Error("Portfolio section failed",
+					"error", err,
+					"section", section,
+					"path", c.Request.URL.Path,
+				)
+			}
+
+			mu.Lock()
+			sectionErrors[section] = message
+			mu.Unlock()
+		}()
+	}
+
+	load("profile", "profile not found", "failed to fetch profile", func() (err error) {
+		bundle.Profile, err = h.repo.GetProfile(ctx)
+		return err
+	})
+	load("experience", "", "failed to fetch work experience", func() (err error) {
+		bundle.Experience, err = h.repo.GetAllWorkExperience(ctx)
+		return err
+	})
+	load("certifications", "", "failed to fetch certifications", func() (err error) {
+		bundle.Certifications, err = h.repo.GetAllCertifications(ctx)
+		return err
+	})
+	load("skills", "", "failed to fetch skills", func() (err error) {
+		bundle.Skills, err = h.repo.GetAllSkills(ctx)
+		return err
+	})
+	load("projects", "", "failed to fetch projects", func() (err error) {
+		bundle.Projects, err = h.repo.GetAllProjects(ctx)
+		return err
+	})
+
+	wg.Wait()
+
+	if len(sectionErrors) == 0 {
+		c.JSON(http.StatusOK, bundle)
+		return
+	}
+
+	// Never let shared caches keep a partial document
+	c.Header("Cache-Control", "no-store")
+	bundle.Errors = sectionErrors
+
+	if len(sectionErrors) == sections {
+		commonHandlers.RespondError(c, http.StatusInternalServerError, "failed to fetch portfolio")
+		return
+	}
+	c.JSON(http.StatusOK, bundle)
+}
@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/vcard"
 	"github.com/gin-gonic/gin"
+	qrcode "github.com/skip2/go-qrcode"
 )
 
 // GetProfile godoc
@@ -26,3 +29,88 @@ func (h *Handler) GetProfile(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, profile)
 }
+
+// GetProfileVCard godoc
+// @Summary Get profile as vCard
+// @Description Get the portfolio owner's contact details as a vCard 4.0 (RFC 6350)
+// @Tags profile
+// @Produce text/vcard
+// @Success 200 {string} string "vCard document"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /profile.vcf [get]
+func (h *Handler) GetProfileVCard(c *gin.Context) {
+	profile, err := h.repo.GetProfile(c.Request.Context())
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="contact.vcf"`)
+	c.Data(http.StatusOK, vcard.ContentType, []byte(vcard.FromProfile(profile, h.siteURL)))
+}
+
+// QR code sizes in pixels
+const (
+	defaultQRSize = 512
+	minQRSize     = 128
+	maxQRSize     = 1024
+)
+
+// QR code contents
+const (
+	qrContentVCard = "vcard"
+	qrContentURL   = "url"
+)
+
+// GetProfileQR godoc
+// @Summary Get profile QR code
+// @Description Get a PNG QR code encoding a compact profile vCard (default) or the public site URL.
+// @Description The url option requires PUBLIC_SITE_URL to be configured.
+// @Tags profile
+// @Produce png
+// @Param content query string false "QR content: vcard (default) or url"
+// @Param size query int false "Image size in pixels (128-1024, default 512)"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /profile/qr.png [get]
+func (h *Handler) GetProfileQR(c *gin.Context) {
+	size := defaultQRSize
+	if raw, ok := c.GetQuery("size"); ok {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < minQRSize || parsed > maxQRSize {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid size")
+			return
+		}
+		size = parsed
+	}
+
+	var content string
+	switch c.DefaultQuery("content", qrContentVCard) {
+	case qrContentVCard:
+		profile, err := h.repo.GetProfile(c.Request.Context())
+		if err != nil {
+			commonHandlers.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+			return
+		}
+		content = vcard.Compact(profile, h.siteURL)
+	case qrContentURL:
+		if !h.requireSiteURL(c) {
+			return
+		}
+		content = h.siteURL
+	default:
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid content")
+		return
+	}
+
+	// Medium error correction keeps a full vCard scannable at business card sizes
+	png, err := qrcode.Encode(content, qrcode.Medium, size)
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to generate qr code")
+		return
+	}
+	c.Data(http.StatusOK, "image/png", png)
+}
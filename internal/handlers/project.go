@@ -1,30 +1,61 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
 	"github.com/gin-gonic/gin"
 )
 
 // GetProjects godoc
 // @Summary Get all portfolio projects
-// @Description Get list of all portfolio projects with technologies
+// @Description Get a page of portfolio projects with technologies, wrapped in an envelope with data and
+// @Description pagination. Without page or limit the first 20 projects are returned.
 // @Tags projects
 // @Produce json
-// @Success 200 {array} models.PortfolioProject
+// @Param page query int false "Page number (1-based)"
+// @Param limit query int false "Page size (max 100)"
+// @Param sort query string false "Comma-separated sort fields, prefix with - for descending (title, category, featured, displayOrder, startDate, endDate, createdAt, updatedAt)"
+// @Param category query string false "Filter by category"
+// @Param featured query bool false "Filter by featured flag"
+// @Param isOngoing query bool false "Filter by ongoing flag"
+// @Param technology query string false "Filter by technology (skill ID or name)"
+// @Success 200 {object} models.PaginatedResponse[models.PortfolioProject]
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /projects [get]
 func (h *Handler) GetProjects(c *gin.Context) {
-	var projects []models.PortfolioProject
-	projects, err := h.repo.GetAllProjects(c.Request.Context())
+	pagination, paged, err := parsePagination(c)
 	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	filter, err := parseProjectFilter(c)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !paged {
+		pagination = repository.Pagination{Page: 1, Limit: defaultPageLimit}
+	}
+	filter.Pagination = pagination
+
+	projects, total, err := h.repo.ListProjects(c.Request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidSort) {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid sort field")
+			return
+		}
 		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch projects")
 		return
 	}
-	c.JSON(http.StatusOK, projects)
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(projects, pagination.Page, pagination.Limit, total))
 }
 
 // GetProjectByID godoc
@@ -33,7 +64,7 @@ func (h *Handler) GetProjects(c *gin.Context) {
 // @Tags projects
 // @Produce json
 // @Param id path int true "Project ID"
-// @Success 200 {object} models.PortfolioProject
+// @Success 200 {object} models.ProjectDetail
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
@@ -52,5 +83,37 @@ func (h *Handler) GetProjectByID(c *gin.Context) {
 		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
 		return
 	}
-	c.JSON(http.StatusOK, project)
+	h.respondProject(c, project)
+}
+
+// GetProjectBySlug godoc
+// @Summary Get portfolio project by slug
+// @Description Get detailed information about a portfolio project by its canonical slug, derived from the title.
+// @Description Older forms of the slug (a bare ID, "<id>-<title>" or a duplicate suffix no longer needed) are
+// @Description answered with a 301 redirect to the canonical slug.
+// @Tags projects
+// @Produce json
+// @Param slug path string true "Project slug, e.g. portfolio-website"
+// @Success 200 {object} models.ProjectDetail
+// @Success 301 "Redirect to the canonical slug"
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /projects/by-slug/{slug} [get]
+func (h *Handler) GetProjectBySlug(c *gin.Context) {
+	project, err := h.repo.GetProjectBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
+		return
+	}
+	h.respondProject(c, project)
+}
+
+// respondProject writes a project together with its canonical slug,
+// redirecting requests by an older form of the slug to it
+func (h *Handler) respondProject(c *gin.Context, project *models.PortfolioProject) {
+	slug, ok := canonicalSlug(c, h.repo.GetProjectSlugs, project.ID, "failed to fetch project")
+	if !ok || redirectToSlug(c, slug) {
+		return
+	}
+	c.JSON(http.StatusOK, models.ProjectDetail{PortfolioProject: *project, Slug: slug})
 }
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/public-api/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+var (
+	errInvalidPage  = errors.New("invalid page")
+	errInvalidLimit = errors.New("invalid limit")
+)
+
+// parsePagination reads the page and limit query parameters.
+// Paging is only enabled when at least one of them is present, so callers
+// that omit both keep receiving the full list.
+func parsePagination(c *gin.Context) (repository.Pagination, bool, error) {
+	pageStr, hasPage := c.GetQuery("page")
+	limitStr, hasLimit := c.GetQuery("limit")
+	if !hasPage && !hasLimit {
+		return repository.Pagination{}, false, nil
+	}
+
+	pagination := repository.Pagination{Page: 1, Limit: defaultPageLimit}
+
+	if hasPage {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			return repository.Pagination{}, false, errInvalidPage
+		}
+		pagination.Page = page
+	}
+
+	if hasLimit {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxPageLimit {
+			return repository.Pagination{}, false, errInvalidLimit
+		}
+		pagination.Limit = limit
+	}
+
+	return pagination, true, nil
+}
+
+// parseOptionalBool reads a boolean query parameter, returning nil when it is absent
+func parseOptionalBool(c *gin.Context, key string) (*bool, error) {
+	raw, ok := c.GetQuery(key)
+	if !ok || raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, errors.New("invalid " + key)
+	}
+	return &value, nil
+}
+
+// parseOptionalID reads a positive integer ID query parameter, returning nil when it is absent
+func parseOptionalID(c *gin.Context, key string) (*int64, error) {
+	raw, ok := c.GetQuery(key)
+	if !ok || raw == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 1 {
+		return nil, errors.New("invalid " + key)
+	}
+	return &id, nil
+}
+
+// parseProjectFilter reads the project list filters and sort order, leaving pagination unset
+func parseProjectFilter(c *gin.Context) (repository.ProjectFilter, error) {
+	featured, err := parseOptionalBool(c, "featured")
+	if err != nil {
+		return repository.ProjectFilter{}, err
+	}
+
+	isOngoing, err := parseOptionalBool(c, "isOngoing")
+	if err != nil {
+		return repository.ProjectFilter{}, err
+	}
+
+	return repository.ProjectFilter{
+		Category:   c.Query("category"),
+		Featured:   featured,
+		IsOngoing:  isOngoing,
+		Technology: c.Query("technology"),
+		Sort:       c.Query("sort"),
+	}, nil
+}
+
+// parseMiniatureFilter reads the miniature list filters and sort order, leaving pagination unset
+func parseMiniatureFilter(c *gin.Context) (repository.MiniatureFilter, error) {
+	themeID, err := parseOptionalID(c, "themeId")
+	if err != nil {
+		return repository.MiniatureFilter{}, err
+	}
+
+	techniqueID, err := parseOptionalID(c, "techniqueId")
+	if err != nil {
+		return repository.MiniatureFilter{}, err
+	}
+
+	paintID, err := parseOptionalID(c, "paintId")
+	if err != nil {
+		return repository.MiniatureFilter{}, err
+	}
+
+	return repository.MiniatureFilter{
+		ThemeID:      themeID,
+		Scale:        c.Query("scale"),
+		Manufacturer: c.Query("manufacturer"),
+		Difficulty:   c.Query("difficulty"),
+		TechniqueID:  techniqueID,
+		PaintID:      paintID,
+		Sort:         c.Query("sort"),
+	}, nil
+}
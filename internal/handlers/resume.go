@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/portfolio-common/logger"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/resume"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sync/errgroup"
+)
+
+// GetResumeJSON godoc
+// @Summary Get résumé in JSON Resume format
+// @Description Get profile, work experience, certifications, skills and projects mapped to the
+// @Description JSON Resume v1.0.0 schema (https://jsonresume.org/schema) for use with résumé themes and job boards
+// @Tags resume
+// @Produce json
+// @Success 200 {object} resume.Resume
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /resume.json [get]
+func (h *Handler) GetResumeJSON(c *gin.Context) {
+	data, err := h.loadResumeData(c.Request.Context())
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "profile not found", "failed to build resume")
+		return
+	}
+	c.JSON(http.StatusOK, resume.Build(data))
+}
+
+// resumeSourceUpload selects the manually uploaded résumé instead of the generated one
+const resumeSourceUpload = "upload"
+
+// GetResumePDF godoc
+// @Summary Get résumé as PDF
+// @Description Get a PDF résumé rendered from the current profile, work experience, certifications,
+// @Description skills and projects. The rendered file is reused until the underlying data changes.
+// @Description With source=upload, or if rendering fails, redirects to the manually uploaded résumé file.
+// @Tags resume
+// @Produce application/pdf
+// @Param source query string false "Set to \"upload\" for the uploaded résumé file"
+// @Success 200 {file} file
+// @Success 302 "Redirect to the uploaded résumé file"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /resume.pdf [get]
+func (h *Handler) GetResumePDF(c *gin.Context) {
+	source := c.Query("source")
+	if source != "" && source != resumeSourceUpload {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid source")
+		return
+	}
+
+	data, err := h.loadResumeData(c.Request.Context())
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "profile not found", "failed to build resume")
+		return
+	}
+
+	uploadedURL := uploadedResumeURL(data.Profile)
+	if source == resumeSourceUpload {
+		if uploadedURL == "" {
+			commonHandlers.RespondError(c, http.StatusNotFound, "uploaded resume not found")
+			return
+		}
+		c.Redirect(http.StatusFound, uploadedURL)
+		return
+	}
+
+	pdf, err := h.resumePDF.Get(resume.Build(data))
+	if err != nil {
+		if uploadedURL == "" {
+			commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to render resume")
+			return
+		}
+		logger.GetLogger(c).Error("Resume rendering failed, falling back to uploaded file",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		c.Redirect(http.StatusFound, uploadedURL)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="resume.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// uploadedResumeURL returns the URL of the manually uploaded résumé, if any
+func uploadedResumeURL(profile *models.Profile) string {
+	if profile == nil || profile.ResumeFile == nil {
+		return ""
+	}
+	return profile.ResumeFile.URL
+}
+
+// loadResumeData fetches every résumé section concurrently.
+// Unlike the portfolio bundle a résumé is all-or-nothing, so the first error cancels the rest.
+func (h *Handler) loadResumeData(ctx context.Context) (resume.Data, error) {
+	var data resume.Data
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() (err error) {
+		data.Profile, err = h.repo.GetProfile(ctx)
+		return err
+	})
+	g.Go(func() (err error) {
+		data.Experience, err = h.repo.GetAllWorkExperience(ctx)
+		return err
+	})
+	g.Go(func() (err error) {
+		data.Certifications, err = h.repo.GetAllCertifications(ctx)
+		return err
+	})
+	g.Go(func() (err error) {
+		data.Skills, err = h.repo.GetAllSkills(ctx)
+		return err
+	})
+	g.Go(func() (err error) {
+		data.Projects, err = h.repo.GetAllProjects(ctx)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return resume.Data{}, err
+	}
+	return data, nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+	maxSearchQueryLen  = 200
+)
+
+var (
+	errMissingQuery = errors.New("q is required")
+	errQueryTooLong = errors.New("q is too long")
+	errInvalidType  = errors.New("invalid type")
+)
+
+// Search godoc
+// @Summary Full-text search
+// @Description Search projects, work experience, skills and miniatures.
+// @Description q uses web search syntax: quoted phrases, OR, and -term to exclude.
+// @Description Results are ranked by relevance; snippets are HTML-escaped with matches wrapped in <mark> tags.
+// @Tags search
+// @Produce json
+// @Param q query string true "Search query"
+// @Param types query string false "Comma-separated result types: project, experience, skill, miniature"
+// @Param limit query int false "Maximum number of results (default 20, max 50)"
+// @Success 200 {array} models.SearchResult
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /search [get]
+func (h *Handler) Search(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		commonHandlers.RespondError(c, http.StatusBadRequest, errMissingQuery.Error())
+		return
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLen {
+		commonHandlers.RespondError(c, http.StatusBadRequest, errQueryTooLong.Error())
+		return
+	}
+
+	var types []string
+	if raw := c.Query("types"); raw != "" {
+		for _, t := range strings.Split(raw, ",") {
+			t = strings.TrimSpace(t)
+			if !slices.Contains(models.SearchTypes, t) {
+				commonHandlers.RespondError(c, http.StatusBadRequest, errInvalidType.Error())
+				return
+			}
+			types = append(types, t)
+		}
+	}
+
+	limit := defaultSearchLimit
+	if raw, ok := c.GetQuery("limit"); ok {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxSearchLimit {
+			commonHandlers.RespondError(c, http.StatusBadRequest, errInvalidLimit.Error())
+			return
+		}
+		limit = parsed
+	}
+
+	results, err := h.repo.Search(c.Request.Context(), query, types, limit)
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to search")
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/sitemap"
+	"github.com/gin-gonic/gin"
+)
+
+// GetSitemap godoc
+// @Summary Sitemap of public website pages
+// @Description Get a sitemaps.org sitemap of every project, miniature and theme page on the public website.
+// @Description When there are more URLs than one sitemap may hold, a sitemap index is returned instead
+// @Description and each sitemap is served with the page parameter. Served at the root (/sitemap.xml),
+// @Description not under the API base path.
+// @Tags seo
+// @Produce xml
+// @Param page query int false "Sitemap page listed in the sitemap index"
+// @Success 200 {string} string "Sitemap or sitemap index document"
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sitemap.xml [get]
+func (h *Handler) GetSitemap(c *gin.Context) {
+	if !h.requireSiteURL(c) {
+		return
+	}
+
+	page := 0
+	if raw, ok := c.GetQuery("page"); ok {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			commonHandlers.RespondError(c, http.StatusBadRequest, "invalid page")
+			return
+		}
+		page = parsed
+	}
+
+	urls, err := h.loadSitemapURLs(c.Request.Context())
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to build sitemap")
+		return
+	}
+
+	pages := sitemap.Pages(len(urls), sitemap.MaxURLs)
+	var body []byte
+	switch {
+	case page > 0:
+		chunk, ok := sitemap.Page(urls, page, sitemap.MaxURLs)
+		if !ok {
+			commonHandlers.RespondError(c, http.StatusNotFound, "sitemap page not found")
+			return
+		}
+		body, err = sitemap.Encode(chunk)
+	case pages > 1:
+		index := make([]sitemap.URL, 0, pages)
+		for page := 1; page <= pages; page++ {
+			chunk, _ := sitemap.Page(urls, page, sitemap.MaxURLs)
+			index = append(index, sitemap.URL{
+				Loc:     fmt.Sprintf("%s/sitemap.xml?page=%d", h.siteURL, page),
+				LastMod: sitemap.LastModified(chunk),
+			})
+		}
+		body, err = sitemap.EncodeIndex(index)
+	default:
+		body, err = sitemap.Encode(urls)
+	}
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to build sitemap")
+		return
+	}
+	c.Data(http.StatusOK, sitemap.ContentType, body)
+}
+
+// loadSitemapURLs lists the home page followed by every project, theme and miniature page
+func (h *Handler) loadSitemapURLs(ctx context.Context) ([]sitemap.URL, error) {
+	pages, err := h.repo.GetSitemapPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]sitemap.URL, 1, 1+len(pages.Projects)+len(pages.Themes)+len(pages.Miniatures))
+	for _, group := range []struct {
+		path   string
+		stamps []models.PageStamp
+	}{
+		{projectPagePath, pages.Projects},
+		{themePagePath, pages.Themes},
+		{miniaturePagePath, pages.Miniatures},
+	} {
+		for _, stamp := range group.stamps {
+			urls = append(urls, sitemap.URL{Loc: h.siteURL + fmt.Sprintf(group.path, stamp.ID), LastMod: stamp.UpdatedAt})
+		}
+	}
+	// The home page changes whenever any of the content it links to does
+	urls[0] = sitemap.URL{Loc: h.siteURL + "/", LastMod: sitemap.LastModified(urls[1:])}
+
+	return urls, nil
+}
@@ -2,21 +2,40 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
 	"github.com/gin-gonic/gin"
 )
 
+// skillGroupByType is the only supported value of the group query parameter
+const skillGroupByType = "type"
+
 // GetSkills godoc
 // @Summary Get all skills
-// @Description Get list of all visible skills organized by category
+// @Description Get list of all visible skills organized by category.
+// @Description With group=type, returns skill types in display order, each holding its
+// @Description ordered skills and the number of projects using every skill
+// @Description (an array of models.SkillGroup) instead of the flat list.
 // @Tags skills
 // @Produce json
+// @Param group query string false "Group skills; only \"type\" is supported"
 // @Success 200 {array} models.Skill
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /skills [get]
 func (h *Handler) GetSkills(c *gin.Context) {
+	switch c.Query("group") {
+	case "":
+	case skillGroupByType:
+		h.getSkillGroups(c)
+		return
+	default:
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid group")
+		return
+	}
+
 	var skills []models.Skill
 	skills, err := h.repo.GetAllSkills(c.Request.Context())
 	if err != nil {
@@ -25,3 +44,39 @@ func (h *Handler) GetSkills(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, skills)
 }
+
+func (h *Handler) getSkillGroups(c *gin.Context) {
+	groups, err := h.repo.GetSkillGroups(c.Request.Context())
+	if err != nil {
+		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch skills")
+		return
+	}
+	c.JSON(http.StatusOK, groups)
+}
+
+// GetSkillProjects godoc
+// @Summary Get projects using a skill
+// @Description Get a visible skill together with every portfolio project that lists it as a technology
+// @Tags skills
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Success 200 {object} models.SkillProjects
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /skills/{id}/projects [get]
+func (h *Handler) GetSkillProjects(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	skillProjects, err := h.repo.GetSkillProjects(c.Request.Context(), id)
+	if err != nil {
+		commonHandlers.HandleRepositoryError(c, err, "skill not found", "failed to fetch skill projects")
+		return
+	}
+	c.JSON(http.StatusOK, skillProjects)
+}
@@ -0,0 +1,82 @@
+package imaging
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/GunarsK-portfolio/public-api/internal/colour"
+	"github.com/buckket/go-blurhash"
+)
+
+// Placeholders are computed from a small copy of the image, which is all a
+// blur needs and keeps the cost independent of the original size
+const (
+	placeholderWidth      = 32
+	blurHashComponents    = 4 // Along the longer side
+	blurHashMinComponents = 3 // Along the shorter side
+)
+
+// Info describes a stored image: its upright size, the data a client needs to
+// draw a placeholder while the image loads, and its safe metadata
+type Info struct {
+	Width         int    `json:"width"`
+	Height        int    `json:"height"`
+	BlurHash      string `json:"blurHash"`
+	DominantColor string `json:"dominantColor"` // #rrggbb
+	CapturedAt    string `json:"capturedAt,omitempty"`
+	Orientation   int    `json:"orientation,omitempty"`
+}
+
+// Analyze measures img and computes its BlurHash and dominant colour.
+// Transparent areas count as white, as in the resized JPEGs. The metadata
+// fields are left for the caller.
+func Analyze(img image.Image) (Info, error) {
+	b := img.Bounds()
+	small := flatten(Resize(img, placeholderWidth))
+
+	x, y := blurHashComponents, blurHashMinComponents
+	if b.Dy() > b.Dx() {
+		x, y = y, x
+	}
+	hash, err := blurhash.Encode(x, y, small)
+	if err != nil {
+		return Info{}, fmt.Errorf("failed to encode blurhash: %w", err)
+	}
+
+	return Info{
+		Width:         b.Dx(),
+		Height:        b.Dy(),
+		BlurHash:      hash,
+		DominantColor: dominantColor(small).Hex(),
+	}, nil
+}
+
+// dominantColor groups the pixels of img into 4096 colour buckets (4 bits per
+// channel) and returns the mean colour of the most populated bucket, so a
+// large flat background wins over a gradient that only averages to a colour
+func dominantColor(img *image.RGBA) colour.RGB {
+	type bucket struct {
+		count   int
+		r, g, b int
+	}
+	var buckets [4096]bucket
+	best := 0
+	for i := 0; i+3 < len(img.Pix); i += 4 {
+		r, g, b := int(img.Pix[i]), int(img.Pix[i+1]), int(img.Pix[i+2])
+		key := r>>4<<8 | g>>4<<4 | b>>4
+		bk := &buckets[key]
+		bk.count++
+		bk.r += r
+		bk.g += g
+		bk.b += b
+		if bk.count > buckets[best].count {
+			best = key
+		}
+	}
+
+	bk := buckets[best]
+	if bk.count == 0 {
+		return colour.RGB{R: 0xff, G: 0xff, B: 0xff}
+	}
+	return colour.RGB{R: uint8(bk.r / bk.count), G: uint8(bk.g / bk.count), B: uint8(bk.b / bk.count)}
+}
@@ -0,0 +1,74 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+
+	// Decoders for the formats the Files API stores
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+// Limits on downloaded pictures, guarding against oversized files and
+// decompression bombs that are small on the wire but huge in memory
+const (
+	maxImageBytes  = 20 << 20
+	maxImagePixels = 40_000_000
+)
+
+// FetchImage downloads and decodes the picture at url, turned upright
+// according to its EXIF orientation, and returns its safe metadata
+func FetchImage(ctx context.Context, client *http.Client, url string) (image.Image, Metadata, error) {
+	resp, err := get(ctx, client, url)
+	if err != nil {
+		return nil, Metadata{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, Metadata{}, fmt.Errorf("failed to download image: %w", err)
+	}
+	if len(data) > maxImageBytes {
+		return nil, Metadata{}, fmt.Errorf("image exceeds %d bytes", maxImageBytes)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, Metadata{}, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, Metadata{}, fmt.Errorf("image dimensions %dx%d are too large", config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, Metadata{}, fmt.Errorf("failed to decode image: %w", err)
+	}
+	meta := ReadMetadata(data)
+	return Orient(img, meta.Orientation), meta, nil
+}
+
+func get(ctx context.Context, client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to download image: status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
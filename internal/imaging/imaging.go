@@ -0,0 +1,139 @@
+// Package imaging downloads, measures, resizes and encodes the pictures the
+// Files API stores, using only Go's image libraries.
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"math"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+)
+
+// JPEGQuality balances size and fidelity for photos of painted miniatures
+const JPEGQuality = 82
+
+// Output formats of rendered images, as named by the fmt parameter of the image endpoint
+const (
+	FormatJPEG = "jpeg"
+	FormatWebP = "webp"
+)
+
+// MayBeTransparent reports whether an original of the given MIME type can
+// have an alpha channel, which JPEG would flatten onto white
+func MayBeTransparent(mimeType string) bool {
+	switch mimeType {
+	case "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
+
+// Formats lists the output formats of an original of the given MIME type,
+// preferred first. EncodeWebP is lossless and usually larger than JPEG for
+// photos, so WebP only comes first for originals that may be transparent.
+func Formats(mimeType string) []string {
+	if MayBeTransparent(mimeType) {
+		return []string{FormatWebP, FormatJPEG}
+	}
+	return []string{FormatJPEG, FormatWebP}
+}
+
+// Variant is a named target width for responsive images
+type Variant struct {
+	Name  string
+	Width int
+}
+
+// Variants are the widths offered for every stored image, smallest first
+var Variants = []Variant{
+	{Name: "thumb", Width: 320},
+	{Name: "medium", Width: 768},
+	{Name: "large", Width: 1600},
+}
+
+// Size is the pixel size of one variant of a particular image
+type Size struct {
+	Variant       Variant
+	Width, Height int
+}
+
+// Sizes returns the variant sizes of an image with the given dimensions.
+// Images are never enlarged: variants wider than the original are narrowed to
+// its width, and a variant that would repeat the previous size is dropped.
+func Sizes(width, height int) []Size {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	sizes := make([]Size, 0, len(Variants))
+	for _, variant := range Variants {
+		w := min(variant.Width, width)
+		if len(sizes) > 0 && sizes[len(sizes)-1].Width == w {
+			break
+		}
+		sizes = append(sizes, Size{Variant: variant, Width: w, Height: ScaledHeight(width, height, w)})
+	}
+	return sizes
+}
+
+// IsVariantWidth reports whether width is one of the offered variant widths
+func IsVariantWidth(width int) bool {
+	for _, variant := range Variants {
+		if variant.Width == width {
+			return true
+		}
+	}
+	return false
+}
+
+// ScaledHeight returns the height that keeps the aspect ratio of width×height at newWidth
+func ScaledHeight(width, height, newWidth int) int {
+	return max(1, int(math.Round(float64(height)*float64(newWidth)/float64(width))))
+}
+
+// Resize scales src down to width, keeping its aspect ratio. Images that are
+// already narrower are returned unchanged.
+func Resize(src image.Image, width int) image.Image {
+	return Transform(src, Params{Width: width})
+}
+
+// EncodeJPEG writes img as a JPEG, flattening any transparency onto white
+func EncodeJPEG(w io.Writer, img image.Image) error {
+	if !opaque(img) {
+		img = flatten(img)
+	}
+	if err := jpeg.Encode(w, img, &jpeg.Options{Quality: JPEGQuality}); err != nil {
+		return fmt.Errorf("failed to encode image: %w", err)
+	}
+	return nil
+}
+
+// flatten draws img onto a white background whose bounds start at the origin
+func flatten(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	flat := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), img, b.Min, draw.Over)
+	return flat
+}
+
+// EncodeWebP writes img as a lossless WebP. Go has no lossy WebP encoder, so
+// for photos this is usually larger than EncodeJPEG and mainly pays off for
+// flat artwork and images with transparency.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	if err := nativewebp.Encode(w, img, nil); err != nil {
+		return fmt.Errorf("failed to encode image: %w", err)
+	}
+	return nil
+}
+
+func opaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
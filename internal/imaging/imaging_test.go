@@ -0,0 +1,388 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/buckket/go-blurhash"
+)
+
+func testPicture(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 0x80, A: 0xff})
+		}
+	}
+	return img
+}
+
+func TestSizes(t *testing.T) {
+	thumb, medium, large := Variants[0], Variants[1], Variants[2]
+	tests := []struct {
+		name          string
+		width, height int
+		want          []Size
+	}{
+		{"large original", 4000, 3000, []Size{{thumb, 320, 240}, {medium, 768, 576}, {large, 1600, 1200}}},
+		{"portrait", 1200, 1800, []Size{{thumb, 320, 480}, {medium, 768, 1152}, {large, 1200, 1800}}},
+		{"small original", 500, 333, []Size{{thumb, 320, 213}, {medium, 500, 333}}},
+		{"tiny original", 100, 50, []Size{{thumb, 100, 50}}},
+		{"unknown size", 0, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sizes(tt.width, tt.height)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Sizes(%d, %d) = %v, want %v", tt.width, tt.height, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Sizes(%d, %d)[%d] = %v, want %v", tt.width, tt.height, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	if !IsVariantWidth(768) || IsVariantWidth(500) {
+		t.Error("IsVariantWidth() accepts only the variant widths")
+	}
+
+	// Lossless WebP only comes first where JPEG would lose transparency
+	if got := Formats("image/jpeg"); len(got) != 2 || got[0] != FormatJPEG || got[1] != FormatWebP {
+		t.Errorf("Formats(image/jpeg) = %v, want [jpeg webp]", got)
+	}
+	if got := Formats("image/png"); len(got) != 2 || got[0] != FormatWebP || got[1] != FormatJPEG {
+		t.Errorf("Formats(image/png) = %v, want [webp jpeg]", got)
+	}
+}
+
+func TestResizeAndEncode(t *testing.T) {
+	resized := Resize(testPicture(800, 600), 320)
+	if resized.Bounds().Dx() != 320 || resized.Bounds().Dy() != 240 {
+		t.Errorf("Resize() bounds = %v, want 320x240", resized.Bounds())
+	}
+	small := testPicture(100, 80)
+	if Resize(small, 320) != image.Image(small) {
+		t.Error("Resize() enlarged a narrower image")
+	}
+
+	// Transparent areas become white rather than black
+	transparent := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	var buf bytes.Buffer
+	if err := EncodeJPEG(&buf, transparent); err != nil {
+		t.Fatalf("EncodeJPEG() error = %v", err)
+	}
+	decoded, err := jpeg.Decode(&buf)
+	if err != nil {
+		t.Fatalf("jpeg.Decode() error = %v", err)
+	}
+	if r, g, b, _ := decoded.At(8, 8).RGBA(); r < 0xf000 || g < 0xf000 || b < 0xf000 {
+		t.Errorf("transparent pixel encoded as %v, want white", decoded.At(8, 8))
+	}
+}
+
+func TestTransform(t *testing.T) {
+	src := testPicture(800, 600)
+
+	tests := []struct {
+		name          string
+		params        Params
+		width, height int
+	}{
+		{"width only", Params{Width: 400}, 400, 300},
+		{"height only", Params{Height: 150}, 200, 150},
+		{"inside box", Params{Width: 400, Height: 400}, 400, 300},
+		{"inside never enlarges", Params{Width: 1600, Height: 1600}, 800, 600},
+		{"cover crops to the box", Params{Width: 300, Height: 300, Fit: FitCover}, 300, 300},
+		{"cover keeps the aspect without enlarging", Params{Width: 2000, Height: 1000, Fit: FitCover}, 800, 400},
+		{"cover with one side fits inside", Params{Width: 300, Fit: FitCover}, 300, 225},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Transform(src, tt.params).Bounds()
+			if b.Dx() != tt.width || b.Dy() != tt.height {
+				t.Errorf("Transform(%+v) = %dx%d, want %dx%d", tt.params, b.Dx(), b.Dy(), tt.width, tt.height)
+			}
+		})
+	}
+
+	// The cover crop is centred: a square from a wide image drops the left and right edges
+	img := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	for y := range 100 {
+		for x := range 300 {
+			c := color.RGBA{R: 0xff, A: 0xff}
+			if x >= 100 && x < 200 {
+				c = color.RGBA{G: 0xff, A: 0xff}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	cropped := Transform(img, Params{Width: 50, Height: 50, Fit: FitCover})
+	if r, g, _, _ := cropped.At(25, 25).RGBA(); r != 0 || g != 0xffff {
+		t.Errorf("Transform(cover) centre = %v, want green", cropped.At(25, 25))
+	}
+}
+
+func TestSign(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	params := Params{Width: 200, Height: 100, Fit: FitCover}
+
+	signature := Sign(key, 7, params)
+	if len(signature) != 32 {
+		t.Errorf("Sign() = %q, want 32 hex characters", signature)
+	}
+	if !Verify(key, 7, params, signature) {
+		t.Error("Verify() = false for its own signature")
+	}
+	// The default fit signs the same as an explicit one
+	if Sign(key, 7, Params{Width: 200}) != Sign(key, 7, Params{Width: 200, Fit: FitInside}) {
+		t.Error("Sign() differs between empty and explicit inside fit")
+	}
+
+	for name, ok := range map[string]bool{
+		"other file":       Verify(key, 8, params, signature),
+		"other parameters": Verify(key, 7, Params{Width: 200, Height: 100}, signature),
+		"other key":        Verify([]byte("another key"), 7, params, signature),
+		"not hex":          Verify(key, 7, params, "not-a-signature"),
+		"empty":            Verify(key, 7, params, ""),
+	} {
+		if ok {
+			t.Errorf("Verify(%s) = true, want false", name)
+		}
+	}
+}
+
+func TestFetchImage(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, testPicture(40, 30)); err != nil {
+		t.Fatal(err)
+	}
+	var photo bytes.Buffer
+	if err := jpeg.Encode(&photo, testPicture(40, 30), nil); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok.png":
+			_, _ = w.Write(picture.Bytes())
+		case "/photo.jpg":
+			_, _ = w.Write(withEXIF(photo.Bytes(), 6, "2024:05:01 14:03:22"))
+		case "/text":
+			_, _ = w.Write([]byte("not an image"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	img, meta, err := FetchImage(context.Background(), server.Client(), server.URL+"/ok.png")
+	if err != nil {
+		t.Fatalf("FetchImage() error = %v", err)
+	}
+	if img.Bounds().Dx() != 40 || img.Bounds().Dy() != 30 || meta != (Metadata{}) {
+		t.Errorf("FetchImage() = %v, %+v; want 40x30 without metadata", img.Bounds(), meta)
+	}
+
+	// A phone photo stored sideways is turned upright
+	img, meta, err = FetchImage(context.Background(), server.Client(), server.URL+"/photo.jpg")
+	if err != nil {
+		t.Fatalf("FetchImage(photo) error = %v", err)
+	}
+	want := Metadata{CapturedAt: "2024-05-01T14:03:22", Orientation: 6}
+	if img.Bounds().Dx() != 30 || img.Bounds().Dy() != 40 || meta != want {
+		t.Errorf("FetchImage(photo) = %v, %+v; want 30x40, %+v", img.Bounds(), meta, want)
+	}
+
+	for _, path := range []string{"/missing.png", "/text"} {
+		if _, _, err := FetchImage(context.Background(), server.Client(), server.URL+path); err == nil {
+			t.Errorf("FetchImage(%s) error = nil, want error", path)
+		}
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// Each pixel of a 3x2 picture records its own position
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for y := range 2 {
+		for x := range 3 {
+			src.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), A: 0xff})
+		}
+	}
+	at := func(img image.Image, x, y int) image.Point {
+		c := color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+		return image.Pt(int(c.R), int(c.G))
+	}
+
+	// Source pixels shown at the top-left and top-right corners once upright
+	tests := []struct {
+		orientation   int
+		width, height int
+		left, right   image.Point
+	}{
+		{1, 3, 2, image.Pt(0, 0), image.Pt(2, 0)},
+		{2, 3, 2, image.Pt(2, 0), image.Pt(0, 0)},
+		{3, 3, 2, image.Pt(2, 1), image.Pt(0, 1)},
+		{4, 3, 2, image.Pt(0, 1), image.Pt(2, 1)},
+		{5, 2, 3, image.Pt(0, 0), image.Pt(0, 1)},
+		{6, 2, 3, image.Pt(0, 1), image.Pt(0, 0)},
+		{7, 2, 3, image.Pt(2, 1), image.Pt(2, 0)},
+		{8, 2, 3, image.Pt(2, 0), image.Pt(2, 1)},
+	}
+	for _, tt := range tests {
+		img := Orient(src, tt.orientation)
+		b := img.Bounds()
+		if b.Dx() != tt.width || b.Dy() != tt.height {
+			t.Errorf("Orient(%d) = %dx%d, want %dx%d", tt.orientation, b.Dx(), b.Dy(), tt.width, tt.height)
+			continue
+		}
+		if left, right := at(img, 0, 0), at(img, b.Dx()-1, 0); left != tt.left || right != tt.right {
+			t.Errorf("Orient(%d) corners = %v, %v; want %v, %v", tt.orientation, left, right, tt.left, tt.right)
+		}
+	}
+}
+
+// withEXIF inserts an EXIF segment recording orientation and dateTime after
+// the start marker of a JPEG
+func withEXIF(data []byte, orientation uint16, dateTime string) []byte {
+	le := binary.LittleEndian
+	value := append([]byte(dateTime), 0)
+
+	tiff := le.AppendUint32([]byte("II*\x00"), 8) // Little-endian header, IFD0 at offset 8
+	tiff = le.AppendUint16(tiff, 2)
+	tiff = le.AppendUint16(tiff, 0x0112) // Orientation, one SHORT stored inline
+	tiff = le.AppendUint16(tiff, 3)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint16(tiff, orientation)
+	tiff = le.AppendUint16(tiff, 0)
+	tiff = le.AppendUint16(tiff, 0x0132) // DateTime, ASCII stored after the IFD
+	tiff = le.AppendUint16(tiff, 2)
+	tiff = le.AppendUint32(tiff, uint32(len(value))) //nolint:gosec // A short date
+	tiff = le.AppendUint32(tiff, 8+2+2*12+4)
+	tiff = le.AppendUint32(tiff, 0) // No next IFD
+	tiff = append(tiff, value...)
+
+	segment := binary.BigEndian.AppendUint16([]byte{0xff, 0xe1}, uint16(2+6+len(tiff))) //nolint:gosec // Well under 64 KiB
+	segment = append(segment, "Exif\x00\x00"...)
+	segment = append(segment, tiff...)
+	return slices.Concat(data[:2], segment, data[2:])
+}
+
+type mapStore map[string][]byte
+
+func (m mapStore) Get(ctx context.Context, key string) ([]byte, error) {
+	if value, ok := m[key]; ok {
+		return value, nil
+	}
+	return nil, errors.New("miss")
+}
+
+func (m mapStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m[key] = value
+	return nil
+}
+
+func TestAnalyze(t *testing.T) {
+	// A red background with a blue stripe: red dominates although the mean is purple
+	img := image.NewRGBA(image.Rect(0, 0, 300, 400))
+	for y := range 400 {
+		for x := range 300 {
+			c := color.RGBA{R: 0xc0, G: 0x20, B: 0x20, A: 0xff}
+			if x < 100 {
+				c = color.RGBA{R: 0x20, G: 0x20, B: 0xc0, A: 0xff}
+			}
+			img.Set(x, y, c)
+		}
+	}
+
+	info, err := Analyze(img)
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if info.Width != 300 || info.Height != 400 {
+		t.Errorf("Analyze() size = %dx%d, want 300x400", info.Width, info.Height)
+	}
+	if info.DominantColor != "#c02020" {
+		t.Errorf("Analyze() dominant colour = %s, want #c02020", info.DominantColor)
+	}
+	// Portrait images get more components vertically
+	x, y, err := blurhash.Components(info.BlurHash)
+	if err != nil || x != 3 || y != 4 {
+		t.Errorf("Analyze() blurhash %q components = %d, %d (%v), want 3, 4", info.BlurHash, x, y, err)
+	}
+
+	// Transparent pixels count as white
+	info, err = Analyze(image.NewNRGBA(image.Rect(10, 10, 50, 30)))
+	if err != nil || info.DominantColor != "#ffffff" || info.Width != 40 || info.Height != 20 {
+		t.Errorf("Analyze(transparent) = %+v, %v; want 40x20 #ffffff", info, err)
+	}
+}
+
+func TestInspector(t *testing.T) {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, testPicture(64, 48)); err != nil {
+		t.Fatal(err)
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/ok.png" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(picture.Bytes())
+	}))
+	defer server.Close()
+
+	store := mapStore{}
+	inspector := NewInspector(server.Client(), store, nil)
+	var first Info
+	for i := range 2 {
+		info, err := inspector.Inspect(context.Background(), "images/ok.png", server.URL+"/ok.png")
+		if err != nil || info.Width != 64 || info.Height != 48 || info.BlurHash == "" {
+			t.Fatalf("Inspect() = %+v, %v; want 64x48 with a blurhash", info, err)
+		}
+		if i == 0 {
+			first = info
+		} else if info != first {
+			t.Errorf("Inspect() from cache = %+v, want %+v", info, first)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Files API requests = %d, want 1 (second result from cache)", got)
+	}
+
+	// Failures are remembered for a while, so a broken file is fetched once
+	for range 3 {
+		if _, err := inspector.Inspect(context.Background(), "images/missing.png", server.URL+"/missing.png"); err == nil {
+			t.Error("Inspect(missing) error = nil, want error")
+		}
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("Files API requests = %d, want 2 (failure remembered)", got)
+	}
+	if _, err := inspector.Inspect(context.Background(), "images/missing.png", server.URL+"/missing.png"); !errors.Is(err, ErrInspectFailed) {
+		t.Errorf("Inspect(missing) error = %v, want ErrInspectFailed", err)
+	}
+
+	// Once the failure expires the image is tried again
+	delete(store, infoFailureKeyPrefix+"images/missing.png")
+	if _, err := inspector.Inspect(context.Background(), "images/missing.png", server.URL+"/missing.png"); err == nil {
+		t.Error("Inspect(missing) error = nil, want error")
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Files API requests = %d, want 3 after the failure expired", got)
+	}
+}
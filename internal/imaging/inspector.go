@@ -0,0 +1,95 @@
+package imaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Stored files never change under a key, so image details are kept for long.
+// Inspection downloads and decodes the whole image, so it gets more time than
+// a header read would need. Failures are remembered briefly, so a broken file
+// is not downloaded again by every read that lists it.
+const (
+	infoCacheTTL   = 30 * 24 * time.Hour
+	infoFailureTTL = 10 * time.Minute
+	inspectTimeout = 10 * time.Second
+
+	// Versioned so that details cached before a change to Info are recomputed
+	infoCacheKeyPrefix   = "image:info:v2:"
+	infoFailureKeyPrefix = "image:info:failed:"
+)
+
+// ErrInspectFailed is returned while a recent failure to inspect an image is remembered
+var ErrInspectFailed = errors.New("image inspection failed recently")
+
+// Store caches image details; cache.Store satisfies it
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Inspector analyzes stored images on first use and remembers their details
+type Inspector struct {
+	client *http.Client
+	store  Store
+	logger *slog.Logger
+}
+
+func NewInspector(client *http.Client, store Store, logger *slog.Logger) *Inspector {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Inspector{client: client, store: store, logger: logger}
+}
+
+// Inspect returns the details of the image stored under key and served at url.
+// Failures are logged and returned, and remembered for infoFailureTTL, during
+// which Inspect returns ErrInspectFailed without downloading the image again.
+func (i *Inspector) Inspect(ctx context.Context, key, url string) (Info, error) {
+	cacheKey := infoCacheKeyPrefix + key
+	if data, err := i.store.Get(ctx, cacheKey); err == nil {
+		var info Info
+		if err := json.Unmarshal(data, &info); err == nil {
+			return info, nil
+		}
+	}
+	if _, err := i.store.Get(ctx, infoFailureKeyPrefix+key); err == nil {
+		return Info{}, ErrInspectFailed
+	}
+
+	info, meta, err := i.analyze(ctx, url)
+	if err != nil {
+		i.logger.Warn("Image details unavailable", "key", key, "error", err)
+		// A cancelled request says nothing about the image
+		if ctx.Err() == nil {
+			_ = i.store.Set(ctx, infoFailureKeyPrefix+key, []byte(err.Error()), infoFailureTTL)
+		}
+		return Info{}, err
+	}
+	info.CapturedAt = meta.CapturedAt
+	info.Orientation = meta.Orientation
+
+	data, err := json.Marshal(info)
+	if err == nil {
+		err = i.store.Set(ctx, cacheKey, data, infoCacheTTL)
+	}
+	if err != nil {
+		i.logger.Warn("Failed to cache image details", "key", key, "error", err)
+	}
+	return info, nil
+}
+
+func (i *Inspector) analyze(ctx context.Context, url string) (Info, Metadata, error) {
+	ctx, cancel := context.WithTimeout(ctx, inspectTimeout)
+	defer cancel()
+	img, meta, err := FetchImage(ctx, i.client, url)
+	if err != nil {
+		return Info{}, Metadata{}, err
+	}
+	info, err := Analyze(img)
+	return info, meta, err
+}
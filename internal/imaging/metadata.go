@@ -0,0 +1,91 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"strings"
+	"time"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"golang.org/x/image/draw"
+)
+
+// exifTimeLayout is how EXIF records dates: camera local time without an offset
+const exifTimeLayout = "2006:01:02 15:04:05"
+
+// Metadata is what the service passes on from the EXIF block of an image.
+// Everything else, such as GPS coordinates and camera serial numbers, is dropped,
+// and renditions are encoded from pixels, so they carry no EXIF or XMP at all.
+type Metadata struct {
+	CapturedAt  string // Camera local time as 2006-01-02T15:04:05, empty when unknown
+	Orientation int    // EXIF orientation 1-8, 0 when unknown
+}
+
+// ReadMetadata extracts the safe metadata from an encoded image. Images
+// without EXIF, or with an unreadable block, return zero Metadata.
+func ReadMetadata(data []byte) Metadata {
+	x, err := exif.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Metadata{}
+	}
+
+	var meta Metadata
+	if tag, err := x.Get(exif.Orientation); err == nil {
+		if orientation, err := tag.Int(0); err == nil && orientation >= 1 && orientation <= 8 {
+			meta.Orientation = orientation
+		}
+	}
+	for _, field := range []exif.FieldName{exif.DateTimeOriginal, exif.DateTime} {
+		tag, err := x.Get(field)
+		if err != nil {
+			continue
+		}
+		value, err := tag.StringVal()
+		if err != nil {
+			continue
+		}
+		if t, err := time.Parse(exifTimeLayout, strings.TrimRight(value, "\x00 ")); err == nil {
+			meta.CapturedAt = t.Format("2006-01-02T15:04:05")
+			break
+		}
+	}
+	return meta
+}
+
+// Orient turns img upright according to its EXIF orientation. Orientations
+// 5 to 8 swap width and height.
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	sw, sh := b.Dx(), b.Dy()
+
+	dw, dh := sw, sh
+	if orientation >= 5 {
+		dw, dh = sh, sw
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	// source maps a destination pixel to the source pixel shown there
+	source := map[int]func(x, y int) (int, int){
+		2: func(x, y int) (int, int) { return sw - 1 - x, y },
+		3: func(x, y int) (int, int) { return sw - 1 - x, sh - 1 - y },
+		4: func(x, y int) (int, int) { return x, sh - 1 - y },
+		5: func(x, y int) (int, int) { return y, x },
+		6: func(x, y int) (int, int) { return y, sh - 1 - x },
+		7: func(x, y int) (int, int) { return sw - 1 - y, sh - 1 - x },
+		8: func(x, y int) (int, int) { return sw - 1 - y, x },
+	}[orientation]
+
+	for y := range dh {
+		for x := range dw {
+			sx, sy := source(x, y)
+			copy(dst.Pix[dst.PixOffset(x, y):][:4], src.Pix[src.PixOffset(sx, sy):][:4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,34 @@
+package imaging
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// signatureBytes is the length of a signature before hex encoding
+const signatureBytes = 16
+
+// Sign returns the signature that authorizes rendering the stored file fileID
+// with p: the first 16 bytes of an HMAC-SHA256 of "fileID:width:height:fit"
+// under key, hex encoded. An empty fit is signed as FitInside.
+func Sign(key []byte, fileID int64, p Params) string {
+	fit := p.Fit
+	if fit == "" {
+		fit = FitInside
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = fmt.Fprintf(mac, "%d:%d:%d:%s", fileID, p.Width, p.Height, fit)
+	return hex.EncodeToString(mac.Sum(nil)[:signatureBytes])
+}
+
+// Verify reports whether signature authorizes rendering fileID with p
+func Verify(key []byte, fileID int64, p Params, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	want, _ := hex.DecodeString(Sign(key, fileID, p))
+	return hmac.Equal(got, want)
+}
@@ -0,0 +1,62 @@
+package imaging
+
+import (
+	"image"
+	"math"
+
+	"golang.org/x/image/draw"
+)
+
+// Fit modes of a rendition
+const (
+	FitInside = "inside" // Scale to fit within the box, keeping the aspect ratio
+	FitCover  = "cover"  // Scale to fill the box and crop the overflow around the centre
+)
+
+// Params describe a rendition of a stored image. A zero Width or Height
+// leaves that side unconstrained; FitCover needs both.
+type Params struct {
+	Width, Height int
+	Fit           string
+}
+
+// Transform renders src according to p. Images are never enlarged: a box
+// larger than the image fits it at its own size, and a cover crop of a small
+// image keeps the requested aspect ratio at the image's own resolution.
+func Transform(src image.Image, p Params) image.Image {
+	b := src.Bounds()
+	sw, sh := float64(b.Dx()), float64(b.Dy())
+
+	if p.Fit == FitCover && p.Width > 0 && p.Height > 0 {
+		scale := math.Max(float64(p.Width)/sw, float64(p.Height)/sh)
+		cw := min(b.Dx(), max(1, int(math.Round(float64(p.Width)/scale))))
+		ch := min(b.Dy(), max(1, int(math.Round(float64(p.Height)/scale))))
+		crop := image.Rect(0, 0, cw, ch).Add(b.Min).Add(image.Pt((b.Dx()-cw)/2, (b.Dy()-ch)/2))
+		if scale >= 1 {
+			return scaleTo(src, crop, cw, ch)
+		}
+		return scaleTo(src, crop, p.Width, p.Height)
+	}
+
+	scale := 1.0
+	if p.Width > 0 {
+		scale = math.Min(scale, float64(p.Width)/sw)
+	}
+	if p.Height > 0 {
+		scale = math.Min(scale, float64(p.Height)/sh)
+	}
+	if scale >= 1 {
+		return src
+	}
+	return scaleTo(src, b, max(1, int(math.Round(sw*scale))), max(1, int(math.Round(sh*scale))))
+}
+
+// scaleTo draws the part of src within from into a new width×height image
+func scaleTo(src image.Image, from image.Rectangle, width, height int) image.Image {
+	if from == src.Bounds() && width == from.Dx() && height == from.Dy() {
+		return src
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, from, draw.Src, nil)
+	return dst
+}
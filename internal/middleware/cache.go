@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bufferedWriter holds the response body so a validator can be computed before anything is sent
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+// WriteHeaderNow is deferred until the buffered response is flushed
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return false
+}
+
+// ETag buffers GET responses and adds a strong ETag computed from the serialized payload.
+// Requests whose If-None-Match header matches the current ETag receive 304 Not Modified
+// with no body. Other non-200 responses are passed through without an ETag, and those
+// outside 2xx are marked no-store so shared caches never keep them. So are responses
+// the handler marked no-store itself, which must not be revalidated either.
+//
+// Only use this on routes that produce small documents such as JSON or a generated PDF:
+// the whole body is buffered.
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		buffered := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buffered
+		c.Next()
+		c.Writer = original
+
+		header := original.Header()
+		if !cacheableStatus(buffered.status) {
+			header.Set("Cache-Control", "no-store")
+		}
+
+		if buffered.status != http.StatusOK || header.Get("Cache-Control") == "no-store" {
+			original.WriteHeader(buffered.status)
+			_, _ = original.Write(buffered.body.Bytes())
+			return
+		}
+
+		sum := sha256.Sum256(buffered.body.Bytes())
+		etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+		header.Set("ETag", etag)
+
+		if MatchesETag(c.GetHeader("If-None-Match"), etag) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		original.WriteHeader(http.StatusOK)
+		_, _ = original.Write(buffered.body.Bytes())
+	}
+}
+
+// MatchesETag reports whether an If-None-Match header value matches etag.
+// Per RFC 9110 the comparison is weak, so a W/ prefix on either side is ignored.
+func MatchesETag(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	target := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == target {
+			return true
+		}
+	}
+	return false
+}
+
+// noStoreWriter marks responses no-store when their status is not cacheable,
+// as the status is set and before the headers are sent
+type noStoreWriter struct {
+	gin.ResponseWriter
+}
+
+func (w *noStoreWriter) WriteHeader(code int) {
+	if !cacheableStatus(code) {
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// cacheableStatus reports whether a response with status may keep the route's
+// Cache-Control: successes, and 304 which refreshes a cached success.
+// Errors and redirects must not outlive the condition that caused them.
+func cacheableStatus(status int) bool {
+	return status < http.StatusMultipleChoices || status == http.StatusNotModified
+}
+
+// CacheControl sets the Cache-Control header for every successful response on
+// the route. Responses outside 2xx (other than 304) are sent with no-store.
+func CacheControl(value string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", value)
+		original := c.Writer
+		c.Writer = &noStoreWriter{ResponseWriter: original}
+		c.Next()
+		c.Writer = original
+	}
+}
+
+// PublicCache allows browsers and shared caches to keep a successful response
+// for maxAge, after which they must revalidate (cheaply, thanks to ETag).
+// A zero maxAge forces revalidation on every request.
+func PublicCache(maxAge time.Duration) gin.HandlerFunc {
+	if maxAge <= 0 {
+		return CacheControl("public, no-cache")
+	}
+	return CacheControl(fmt.Sprintf("public, max-age=%d, must-revalidate", int(maxAge.Seconds())))
+}
@@ -0,0 +1,180 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func setupTestRouter(t *testing.T, status int, body string) *gin.Engine {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/resource", ETag(), PublicCache(5*time.Minute), func(c *gin.Context) {
+		c.String(status, body)
+	})
+	return router
+}
+
+func performRequest(t *testing.T, router *gin.Engine, ifNoneMatch string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, "/resource", nil)
+	if err != nil {
+		t.Fatalf("failed to create HTTP request: %v", err)
+	}
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestETag_SetsValidatorAndCacheControl(t *testing.T) {
+	router := setupTestRouter(t, http.StatusOK, `{"name":"test"}`)
+
+	w := performRequest(t, router, "")
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w.Header().Get("ETag") == "" {
+		t.Error("ETag header missing")
+	}
+	if got := w.Header().Get("Cache-Control"); got != "public, max-age=300, must-revalidate" {
+		t.Errorf("Cache-Control = %q, want public max-age=300", got)
+	}
+	if w.Body.String() != `{"name":"test"}` {
+		t.Errorf("body = %q, want original payload", w.Body.String())
+	}
+}
+
+func TestETag_StableForSamePayload(t *testing.T) {
+	router := setupTestRouter(t, http.StatusOK, "payload")
+
+	first := performRequest(t, router, "").Header().Get("ETag")
+	second := performRequest(t, router, "").Header().Get("ETag")
+
+	if first != second {
+		t.Errorf("ETag changed between identical responses: %s != %s", first, second)
+	}
+	if other := performRequest(t, setupTestRouter(t, http.StatusOK, "changed"), "").Header().Get("ETag"); other == first {
+		t.Error("ETag should differ when payload changes")
+	}
+}
+
+func TestETag_NotModified(t *testing.T) {
+	router := setupTestRouter(t, http.StatusOK, "payload")
+	etag := performRequest(t, router, "").Header().Get("ETag")
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        int
+	}{
+		{"exact match", etag, http.StatusNotModified},
+		{"weak match", "W/" + etag, http.StatusNotModified},
+		{"match in list", `"other", ` + etag, http.StatusNotModified},
+		{"wildcard", "*", http.StatusNotModified},
+		{"stale validator", `"stale"`, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performRequest(t, router, tt.ifNoneMatch)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+			if tt.want == http.StatusNotModified {
+				if w.Body.Len() != 0 {
+					t.Errorf("304 response should have empty body, got %q", w.Body.String())
+				}
+				if w.Header().Get("ETag") != etag {
+					t.Error("304 response should repeat the ETag")
+				}
+			}
+		})
+	}
+}
+
+func TestETag_SkipsErrorResponses(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusInternalServerError} {
+		router := setupTestRouter(t, status, "boom")
+
+		w := performRequest(t, router, "*")
+
+		if w.Code != status {
+			t.Errorf("status = %d, want %d", w.Code, status)
+		}
+		if w.Header().Get("ETag") != "" {
+			t.Errorf("%d response should not carry an ETag", status)
+		}
+		if got := w.Header().Get("Cache-Control"); got != "no-store" {
+			t.Errorf("%d Cache-Control = %q, want no-store", status, got)
+		}
+		if w.Body.String() != "boom" {
+			t.Errorf("body = %q, want error payload", w.Body.String())
+		}
+	}
+}
+
+func TestETag_SkipsNoStoreResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/resource", ETag(), PublicCache(5*time.Minute), func(c *gin.Context) {
+		// A degraded response the handler keeps out of caches
+		c.Header("Cache-Control", "no-store")
+		c.String(http.StatusOK, "partial")
+	})
+
+	w := performRequest(t, router, "")
+
+	if w.Code != http.StatusOK || w.Body.String() != "partial" {
+		t.Errorf("status = %d with body %q, want the handler's response", w.Code, w.Body.String())
+	}
+	if w.Header().Get("ETag") != "" {
+		t.Error("no-store response should not carry an ETag")
+	}
+	if got := w.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Cache-Control = %q, want no-store", got)
+	}
+}
+
+func TestPublicCache_NoStoreOutsideSuccess(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	// Unbuffered routes, as used for images and streamed downloads
+	cache := PublicCache(5 * time.Minute)
+	router.GET("/ok", cache, func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+	router.GET("/not-modified", cache, func(c *gin.Context) { c.Status(http.StatusNotModified) })
+	router.GET("/moved", cache, func(c *gin.Context) { c.Redirect(http.StatusMovedPermanently, "/ok") })
+	router.GET("/bad", cache, func(c *gin.Context) { c.JSON(http.StatusBadRequest, gin.H{"error": "bad"}) })
+	router.GET("/missing", cache, func(c *gin.Context) { c.JSON(http.StatusNotFound, gin.H{"error": "missing"}) })
+	router.GET("/gateway", cache, func(c *gin.Context) { c.JSON(http.StatusBadGateway, gin.H{"error": "gateway"}) })
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/ok", "public, max-age=300, must-revalidate"},
+		{"/not-modified", "public, max-age=300, must-revalidate"},
+		{"/moved", "no-store"},
+		{"/bad", "no-store"},
+		{"/missing", "no-store"},
+		{"/gateway", "no-store"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		if got := w.Header().Get("Cache-Control"); got != tt.want {
+			t.Errorf("GET %s (%d) Cache-Control = %q, want %q", tt.path, w.Code, got, tt.want)
+		}
+	}
+}
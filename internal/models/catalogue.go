@@ -0,0 +1,40 @@
+package models
+
+// PaintWithUsage is a catalogue paint with the number of miniatures it was used on
+type PaintWithUsage struct {
+	MiniaturePaint
+	MiniatureCount int64 `json:"miniatureCount"`
+}
+
+// TechniqueWithUsage is a catalogue technique with the number of miniatures it was used on
+type TechniqueWithUsage struct {
+	MiniatureTechnique
+	MiniatureCount int64 `json:"miniatureCount"`
+}
+
+// PaintMiniatures is a catalogue paint with every miniature it was used on
+type PaintMiniatures struct {
+	Paint      PaintWithUsage     `json:"paint"`
+	Miniatures []MiniatureProject `json:"miniatures"`
+}
+
+// TechniqueMiniatures is a catalogue technique with every miniature it was used on
+type TechniqueMiniatures struct {
+	Technique  TechniqueWithUsage `json:"technique"`
+	Miniatures []MiniatureProject `json:"miniatures"`
+}
+
+// SimilarPaint is a catalogue paint with its CIEDE2000 distance from a requested colour
+type SimilarPaint struct {
+	PaintWithUsage
+	DeltaE float64 `json:"deltaE"`
+}
+
+// SimilarPaints lists the paints closest to a colour, nearest first, and
+// optionally the miniatures painted with any of them. Miniatures is nil when
+// they were not requested, so the field is left out rather than sent empty.
+type SimilarPaints struct {
+	Hex        string              `json:"hex"`
+	Paints     []SimilarPaint      `json:"paints"`
+	Miniatures *[]MiniatureProject `json:"miniatures,omitempty"`
+}
@@ -7,14 +7,7 @@ type Certification = commonModels.Certification
 type WorkExperience = commonModels.WorkExperience
 type Skill = commonModels.Skill
 type SkillType = commonModels.SkillType
-type Profile = commonModels.Profile
-type PortfolioProject = commonModels.PortfolioProject
-type MiniatureTheme = commonModels.MiniatureTheme
-type MiniatureProject = commonModels.MiniatureProject
 type MiniaturePaint = commonModels.MiniaturePaint
 type MiniatureTechnique = commonModels.MiniatureTechnique
 type MiniatureProjectTechnique = commonModels.MiniatureProjectTechnique
 type MiniatureProjectPaint = commonModels.MiniatureProjectPaint
-type MiniatureFile = commonModels.MiniatureFile
-type StorageFile = commonModels.StorageFile
-type Image = commonModels.Image
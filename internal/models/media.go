@@ -0,0 +1,81 @@
+package models
+
+import commonModels "github.com/GunarsK-portfolio/portfolio-common/models"
+
+// The models below embed their portfolio-common counterparts and add image
+// metadata that only the public API computes. Associations are redeclared so
+// they load these extended types; the embedded fields of the same name stay empty.
+
+// ImageVariant is a resized rendition of an image in one format, for use in
+// srcset and in the type of a <picture> source
+type ImageVariant struct {
+	Name   string `json:"name"` // thumb, medium or large
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Format string `json:"format"` // jpeg or webp
+	Type   string `json:"type"`   // MIME type, e.g. image/jpeg
+}
+
+// ImageDetails describe a stored image so clients can reserve its space and
+// draw a placeholder while it loads
+type ImageDetails struct {
+	Width         int    `json:"width,omitempty"`
+	Height        int    `json:"height,omitempty"`
+	BlurHash      string `json:"blurHash,omitempty"`
+	DominantColor string `json:"dominantColor,omitempty"` // #rrggbb
+
+	// Safe EXIF metadata. Width and Height and every rendition already have
+	// the orientation applied; it is reported for reference only.
+	CapturedAt  string `json:"capturedAt,omitempty"` // Camera local time, 2006-01-02T15:04:05
+	Orientation int    `json:"orientation,omitempty"`
+}
+
+type StorageFile struct {
+	commonModels.StorageFile
+
+	// Computed from the stored image by the repository layer. Images are
+	// published through the image endpoint, so URL differs from SourceURL,
+	// which the service itself fetches the original from.
+	SourceURL    string `json:"-" gorm:"-"`
+	ImageDetails `gorm:"-"`
+	Variants     []ImageVariant `json:"variants,omitempty" gorm:"-"`
+}
+
+// Image is the simplified view for frontend
+type Image struct {
+	commonModels.Image
+	ImageDetails
+	Variants []ImageVariant `json:"variants,omitempty"`
+}
+
+type Profile struct {
+	commonModels.Profile
+	AvatarFile *StorageFile `json:"avatarFile,omitempty" gorm:"foreignKey:AvatarFileID"`
+	ResumeFile *StorageFile `json:"resumeFile,omitempty" gorm:"foreignKey:ResumeFileID"`
+}
+
+type PortfolioProject struct {
+	commonModels.PortfolioProject
+	ImageFile *StorageFile `json:"imageFile,omitempty" gorm:"foreignKey:ImageFileID"`
+}
+
+type MiniatureTheme struct {
+	commonModels.MiniatureTheme
+	Miniatures     []MiniatureProject `json:"miniatures,omitempty" gorm:"foreignKey:ThemeID"`
+	CoverImageFile *StorageFile       `json:"coverImageFile,omitempty" gorm:"foreignKey:CoverImageID"`
+}
+
+type MiniatureProject struct {
+	commonModels.MiniatureProject
+	Theme          *MiniatureTheme `json:"theme,omitempty" gorm:"foreignKey:ThemeID"`
+	MiniatureFiles []MiniatureFile `json:"-" gorm:"foreignKey:MiniatureProjectID"`
+
+	// Computed field (populated by repository layer - requires URL building)
+	Images []Image `json:"images,omitempty" gorm:"-"`
+}
+
+type MiniatureFile struct {
+	commonModels.MiniatureFile
+	File *StorageFile `json:"file,omitempty" gorm:"foreignKey:FileID"`
+}
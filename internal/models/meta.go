@@ -0,0 +1,11 @@
+package models
+
+// PageMeta is the social preview metadata of a public website page.
+// URL is the canonical page address, omitted when no site URL is configured;
+// Image is an absolute URL when present.
+type PageMeta struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
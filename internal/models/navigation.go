@@ -0,0 +1,24 @@
+package models
+
+// NavItem is a compact reference to another item, enough to render a link
+type NavItem struct {
+	ID    int64  `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+	Image string `json:"image,omitempty"`
+}
+
+// RelatedItem is a related item with how strongly it relates.
+// Higher scores mean more in common; see the repository for the weights.
+type RelatedItem struct {
+	NavItem
+	Score int `json:"score"`
+}
+
+// Navigation places an item among its siblings. Previous and Next follow the
+// list ordering and are nil at either end; Related is ordered by score.
+type Navigation struct {
+	Previous *NavItem      `json:"previous"`
+	Next     *NavItem      `json:"next"`
+	Related  []RelatedItem `json:"related"`
+}
@@ -0,0 +1,37 @@
+package models
+
+// PaginationMeta describes the page window returned by list endpoints
+type PaginationMeta struct {
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"totalPages"`
+}
+
+// PaginatedResponse wraps a page of items with its pagination metadata
+type PaginatedResponse[T any] struct {
+	Data       []T            `json:"data"`
+	Pagination PaginationMeta `json:"pagination"`
+}
+
+// NewPaginatedResponse builds the envelope for a page of items out of total matches
+func NewPaginatedResponse[T any](data []T, page, limit int, total int64) PaginatedResponse[T] {
+	if data == nil {
+		data = []T{}
+	}
+
+	totalPages := 0
+	if limit > 0 {
+		totalPages = int((total + int64(limit) - 1) / int64(limit))
+	}
+
+	return PaginatedResponse[T]{
+		Data: data,
+		Pagination: PaginationMeta{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}
+}
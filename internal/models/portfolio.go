@@ -0,0 +1,12 @@
+package models
+
+// PortfolioBundle composes everything the public site needs for its first paint.
+// Sections that failed to load are left empty and reported in Errors keyed by section name.
+type PortfolioBundle struct {
+	Profile        *Profile           `json:"profile"`
+	Experience     []WorkExperience   `json:"experience"`
+	Certifications []Certification    `json:"certifications"`
+	Skills         []Skill            `json:"skills"`
+	Projects       []PortfolioProject `json:"projects"`
+	Errors         map[string]string  `json:"errors,omitempty"`
+}
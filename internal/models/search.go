@@ -0,0 +1,27 @@
+package models
+
+// Search result types
+const (
+	SearchTypeProject    = "project"
+	SearchTypeExperience = "experience"
+	SearchTypeSkill      = "skill"
+	SearchTypeMiniature  = "miniature"
+)
+
+// SearchTypes lists every searchable result type
+var SearchTypes = []string{
+	SearchTypeProject,
+	SearchTypeExperience,
+	SearchTypeSkill,
+	SearchTypeMiniature,
+}
+
+// SearchResult is a single ranked full-text search hit.
+// Snippet is HTML-escaped text with matched terms wrapped in <mark> tags.
+type SearchResult struct {
+	Type    string  `json:"type"`
+	ID      int64   `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
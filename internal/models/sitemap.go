@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// PageStamp identifies the website page of an item by the item's ID and when it last changed
+type PageStamp struct {
+	ID        int64
+	UpdatedAt time.Time
+}
+
+// SitemapPages lists the items whose website pages the sitemap links to
+type SitemapPages struct {
+	Projects   []PageStamp
+	Themes     []PageStamp
+	Miniatures []PageStamp
+}
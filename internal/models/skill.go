@@ -0,0 +1,19 @@
+package models
+
+// SkillWithUsage is a visible skill with the number of projects listing it as a technology
+type SkillWithUsage struct {
+	Skill
+	ProjectCount int64 `json:"projectCount"`
+}
+
+// SkillGroup is a skill type with its visible skills in display order
+type SkillGroup struct {
+	SkillType
+	Skills []SkillWithUsage `json:"skills"`
+}
+
+// SkillProjects is a visible skill with every project that lists it as a technology
+type SkillProjects struct {
+	Skill    Skill              `json:"skill"`
+	Projects []PortfolioProject `json:"projects"`
+}
@@ -0,0 +1,19 @@
+package models
+
+// ProjectDetail is a project with its canonical slug
+type ProjectDetail struct {
+	PortfolioProject
+	Slug string `json:"slug"`
+}
+
+// MiniatureDetail is a miniature project with its canonical slug
+type MiniatureDetail struct {
+	MiniatureProject
+	Slug string `json:"slug"`
+}
+
+// ThemeDetail is a miniature theme with its canonical slug
+type ThemeDetail struct {
+	MiniatureTheme
+	Slug string `json:"slug"`
+}
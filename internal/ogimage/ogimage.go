@@ -0,0 +1,302 @@
+// Package ogimage renders 1200×630 Open Graph preview cards with the standard
+// image libraries and the embedded Go fonts, so no external tools are needed.
+package ogimage
+
+import (
+	"fmt"
+	"hash/fnv"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Card dimensions recommended by Open Graph and Twitter large image cards
+const (
+	Width  = 1200
+	Height = 630
+)
+
+// Layout, in pixels
+const (
+	margin       = 72
+	imageWidth   = 480 // Right-hand panel when the card has an image
+	accentWidth  = 12
+	titleSize    = 64
+	titleLeading = 76
+	maxTitleRows = 3
+	labelSize    = 30
+	badgeSize    = 26
+	badgeHeight  = 48
+	badgePadding = 20
+	badgeGap     = 14
+	maxBadgeRows = 2
+)
+
+var (
+	background = color.RGBA{R: 0x0f, G: 0x17, B: 0x2a, A: 0xff}
+	accent     = color.RGBA{R: 0x38, G: 0xbd, B: 0xf8, A: 0xff}
+	titleColor = color.RGBA{R: 0xf8, G: 0xfa, B: 0xfc, A: 0xff}
+	mutedColor = color.RGBA{R: 0x94, G: 0xa3, B: 0xb8, A: 0xff}
+
+	// Badge colours are picked per group so every skill type keeps one colour
+	badgePalette = []color.RGBA{
+		{R: 0x1d, G: 0x4e, B: 0xd8, A: 0xff},
+		{R: 0x04, G: 0x78, B: 0x57, A: 0xff},
+		{R: 0xb4, G: 0x53, B: 0x09, A: 0xff},
+		{R: 0x7e, G: 0x22, B: 0xce, A: 0xff},
+		{R: 0xbe, G: 0x12, B: 0x3c, A: 0xff},
+		{R: 0x0f, G: 0x76, B: 0x6e, A: 0xff},
+	}
+)
+
+// Card is the content of one preview image
+type Card struct {
+	Label  string // Small text above the title, such as the project category
+	Title  string
+	Badges []Badge
+	Footer string      // Small text at the bottom, such as the site host
+	Image  image.Image // Optional picture shown in the right-hand panel
+}
+
+// Badge is a labelled chip; badges with the same Group share a colour
+type Badge struct {
+	Label string
+	Group string
+}
+
+var fonts = sync.OnceValues(func() (map[string]*opentype.Font, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*opentype.Font{"regular": regular, "bold": bold}, nil
+})
+
+// Render writes the card as a PNG image
+func Render(w io.Writer, card Card) error {
+	faces, err := newFaces()
+	if err != nil {
+		return err
+	}
+	defer faces.close()
+
+	canvas := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+	draw.Draw(canvas, image.Rect(0, 0, accentWidth, Height), image.NewUniform(accent), image.Point{}, draw.Src)
+
+	textRight := Width - margin
+	if card.Image != nil {
+		panel := image.Rect(Width-imageWidth, 0, Width, Height)
+		drawCover(canvas, panel, card.Image)
+		textRight = panel.Min.X - margin
+	}
+	textWidth := textRight - margin
+
+	y := margin + labelSize
+	if card.Label != "" {
+		drawText(canvas, faces.label, mutedColor, margin, y, truncate(faces.label, strings.ToUpper(card.Label), textWidth))
+		y += titleLeading - titleSize
+	}
+
+	y += titleSize
+	for _, row := range wrap(faces.title, card.Title, textWidth, maxTitleRows) {
+		drawText(canvas, faces.title, titleColor, margin, y, row)
+		y += titleLeading
+	}
+
+	drawBadges(canvas, faces.badge, card.Badges, y, textWidth)
+
+	if card.Footer != "" {
+		drawText(canvas, faces.label, mutedColor, margin, Height-margin+labelSize/2, truncate(faces.label, card.Footer, textWidth))
+	}
+
+	if err := png.Encode(w, canvas); err != nil {
+		return fmt.Errorf("failed to encode preview image: %w", err)
+	}
+	return nil
+}
+
+type faceSet struct {
+	title, label, badge font.Face
+}
+
+func newFaces() (*faceSet, error) {
+	parsed, err := fonts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fonts: %w", err)
+	}
+
+	newFace := func(name string, size float64) (font.Face, error) {
+		return opentype.NewFace(parsed[name], &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	}
+	var set faceSet
+	if set.title, err = newFace("bold", titleSize); err != nil {
+		return nil, fmt.Errorf("failed to create font face: %w", err)
+	}
+	if set.label, err = newFace("regular", labelSize); err != nil {
+		return nil, fmt.Errorf("failed to create font face: %w", err)
+	}
+	if set.badge, err = newFace("bold", badgeSize); err != nil {
+		return nil, fmt.Errorf("failed to create font face: %w", err)
+	}
+	return &set, nil
+}
+
+func (f *faceSet) close() {
+	_ = f.title.Close()
+	_ = f.label.Close()
+	_ = f.badge.Close()
+}
+
+// drawBadges flows badges left to right below top, wrapping to at most
+// maxBadgeRows rows. Badges that do not fit are summarised as a "+N" chip,
+// for which the last row always keeps room.
+func drawBadges(canvas *image.RGBA, face font.Face, badges []Badge, top, width int) {
+	x, row := 0, 0
+	more := func(n int) string { return fmt.Sprintf("+%d", n) }
+	for i, badge := range badges {
+		label := truncate(face, badge.Label, width-2*badgePadding)
+		chip := chipWidth(face, label)
+		y := top + row*(badgeHeight+badgeGap)
+
+		if x > 0 && x+chip > width {
+			if row == maxBadgeRows-1 {
+				drawChip(canvas, face, more(len(badges)-i), mutedColor, margin+x, y)
+				return
+			}
+			x, row = 0, row+1
+			y += badgeHeight + badgeGap
+		}
+		if row == maxBadgeRows-1 && i < len(badges)-1 && x+chip+badgeGap+chipWidth(face, more(len(badges)-i-1)) > width {
+			drawChip(canvas, face, more(len(badges)-i), mutedColor, margin+x, y)
+			return
+		}
+
+		drawChip(canvas, face, label, badgeColor(badge.Group), margin+x, y)
+		x += chip + badgeGap
+	}
+}
+
+func chipWidth(face font.Face, label string) int {
+	return measure(face, label) + 2*badgePadding
+}
+
+func drawChip(canvas *image.RGBA, face font.Face, label string, fill color.Color, x, y int) {
+	rect := image.Rect(x, y, x+chipWidth(face, label), y+badgeHeight)
+	draw.DrawMask(canvas, rect, image.NewUniform(fill), image.Point{}, roundedRect{rect, badgeHeight / 2}, rect.Min, draw.Over)
+	baseline := y + (badgeHeight+face.Metrics().CapHeight.Round())/2
+	drawText(canvas, face, titleColor, x+badgePadding, baseline, label)
+}
+
+func badgeColor(group string) color.RGBA {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(group))
+	return badgePalette[h.Sum32()%uint32(len(badgePalette))]
+}
+
+// drawCover scales src to fill dst, cropping the overflowing axis around the centre
+func drawCover(canvas *image.RGBA, dst image.Rectangle, src image.Image) {
+	b := src.Bounds()
+	if b.Empty() {
+		return
+	}
+	crop := b
+	// Compare aspect ratios without floating point: src is wider when sw/sh > dw/dh
+	if b.Dx()*dst.Dy() > dst.Dx()*b.Dy() {
+		w := b.Dy() * dst.Dx() / dst.Dy()
+		crop.Min.X = b.Min.X + (b.Dx()-w)/2
+		crop.Max.X = crop.Min.X + w
+	} else {
+		h := b.Dx() * dst.Dy() / dst.Dx()
+		crop.Min.Y = b.Min.Y + (b.Dy()-h)/2
+		crop.Max.Y = crop.Min.Y + h
+	}
+	draw.CatmullRom.Scale(canvas, dst, src, crop, draw.Src, nil)
+}
+
+func drawText(canvas *image.RGBA, face font.Face, c color.Color, x, y int, text string) {
+	d := font.Drawer{Dst: canvas, Src: image.NewUniform(c), Face: face, Dot: fixed.P(x, y)}
+	d.DrawString(text)
+}
+
+func measure(face font.Face, text string) int {
+	return font.MeasureString(face, text).Ceil()
+}
+
+// wrap breaks text into at most maxRows lines no wider than width,
+// ending the last line with an ellipsis when text remains
+func wrap(face font.Face, text string, width, maxRows int) []string {
+	var rows []string
+	line := ""
+	words := strings.Fields(text)
+	for i, word := range words {
+		candidate := strings.TrimSpace(line + " " + word)
+		if line == "" || measure(face, candidate) <= width {
+			line = candidate
+			continue
+		}
+		if len(rows) == maxRows-1 {
+			return append(rows, truncate(face, strings.Join(append([]string{line}, words[i:]...), " "), width))
+		}
+		rows = append(rows, truncate(face, line, width))
+		line = word
+	}
+	if line != "" {
+		rows = append(rows, truncate(face, line, width))
+	}
+	return rows
+}
+
+// truncate shortens text with an ellipsis until it fits within width
+func truncate(face font.Face, text string, width int) string {
+	if measure(face, text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimRight(string(runes), " ") + "…"
+		if measure(face, candidate) <= width {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// roundedRect is an alpha mask of a rectangle with rounded corners
+type roundedRect struct {
+	rect   image.Rectangle
+	radius int
+}
+
+func (r roundedRect) ColorModel() color.Model { return color.AlphaModel }
+
+func (r roundedRect) Bounds() image.Rectangle { return r.rect }
+
+func (r roundedRect) At(x, y int) color.Color {
+	if !(image.Point{X: x, Y: y}).In(r.rect) {
+		return color.Transparent
+	}
+	// Distance from the nearest corner centre, for points inside a corner square
+	cx := min(max(x, r.rect.Min.X+r.radius), r.rect.Max.X-r.radius-1)
+	cy := min(max(y, r.rect.Min.Y+r.radius), r.rect.Max.Y-r.radius-1)
+	dx, dy := x-cx, y-cy
+	if dx*dx+dy*dy > r.radius*r.radius {
+		return color.Transparent
+	}
+	return color.Opaque
+}
@@ -0,0 +1,88 @@
+package ogimage
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func testPicture(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 0x80, A: 0xff})
+		}
+	}
+	return img
+}
+
+func TestRender(t *testing.T) {
+	card := Card{
+		Label:  "Web Development",
+		Title:  "Portfolio Platform",
+		Footer: "example.com",
+		Image:  testPicture(300, 200),
+		Badges: []Badge{{Label: "Go", Group: "Backend"}, {Label: "Vue.js", Group: "Frontend"}},
+	}
+
+	var first, second bytes.Buffer
+	if err := Render(&first, card); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if err := Render(&second, card); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("Render() output differs between runs")
+	}
+
+	img, err := png.Decode(&first)
+	if err != nil {
+		t.Fatalf("Render() produced invalid PNG: %v", err)
+	}
+	if img.Bounds() != image.Rect(0, 0, Width, Height) {
+		t.Errorf("bounds = %v, want %dx%d", img.Bounds(), Width, Height)
+	}
+	// The picture fills the right-hand panel
+	if r, _, _, _ := img.At(Width-1, Height/2).RGBA(); r == 0 {
+		t.Errorf("right panel pixel = %v, want picture content", img.At(Width-1, Height/2))
+	}
+
+	var textOnly bytes.Buffer
+	if err := Render(&textOnly, Card{Title: "No picture"}); err != nil {
+		t.Fatalf("Render() without image error = %v", err)
+	}
+	img, _ = png.Decode(&textOnly)
+	if got := color.RGBAModel.Convert(img.At(Width-1, Height/2)); got != background {
+		t.Errorf("text-only right edge = %v, want background", got)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	faces, err := newFaces()
+	if err != nil {
+		t.Fatalf("newFaces() error = %v", err)
+	}
+	defer faces.close()
+
+	width := 400
+	rows := wrap(faces.title, strings.Repeat("Lorem ipsum dolor sit amet ", 10), width, maxTitleRows)
+	if len(rows) != maxTitleRows {
+		t.Fatalf("wrap() = %d rows, want %d", len(rows), maxTitleRows)
+	}
+	for _, row := range rows {
+		if measure(faces.title, row) > width {
+			t.Errorf("row %q is wider than %d", row, width)
+		}
+	}
+	if !strings.HasSuffix(rows[len(rows)-1], "…") {
+		t.Errorf("last row = %q, want ellipsis", rows[len(rows)-1])
+	}
+
+	if rows := wrap(faces.title, "Short", width, maxTitleRows); len(rows) != 1 || rows[0] != "Short" {
+		t.Errorf("wrap() = %q, want single row", rows)
+	}
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+)
+
+// catalogueUsage is one row of the per-paint or per-technique miniature count query
+type catalogueUsage struct {
+	ID             int64
+	MiniatureCount int64
+}
+
+func (r *repository) GetAllPaints(ctx context.Context) ([]models.PaintWithUsage, error) {
+	var paints []models.MiniaturePaint
+	err := r.db.WithContext(ctx).
+		Order("manufacturer ASC, name ASC, id ASC").
+		Find(&paints).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all paints: %w", err)
+	}
+
+	counts, err := r.countUsage(ctx, "miniatures.miniature_paints", "paint_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count paint usage: %w", err)
+	}
+
+	result := make([]models.PaintWithUsage, 0, len(paints))
+	for _, paint := range paints {
+		result = append(result, models.PaintWithUsage{MiniaturePaint: paint, MiniatureCount: counts[paint.ID]})
+	}
+	return result, nil
+}
+
+func (r *repository) GetPaintMiniatures(ctx context.Context, id int64) (*models.PaintMiniatures, error) {
+	var paint models.MiniaturePaint
+	if err := r.db.WithContext(ctx).First(&paint, id).Error; err != nil {
+		return nil, fmt.Errorf("failed to get paint by id %d: %w", id, err)
+	}
+
+	miniatures, total, err := r.ListMiniatureProjects(ctx, MiniatureFilter{PaintID: &id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniatures for paint %d: %w", id, err)
+	}
+
+	return &models.PaintMiniatures{
+		Paint:      models.PaintWithUsage{MiniaturePaint: paint, MiniatureCount: total},
+		Miniatures: miniatures,
+	}, nil
+}
+
+func (r *repository) GetAllTechniques(ctx context.Context) ([]models.TechniqueWithUsage, error) {
+	var techniques []models.MiniatureTechnique
+	err := r.db.WithContext(ctx).
+		Order("display_order ASC, name ASC, id ASC").
+		Find(&techniques).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all techniques: %w", err)
+	}
+
+	counts, err := r.countUsage(ctx, "miniatures.miniature_techniques", "technique_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count technique usage: %w", err)
+	}
+
+	result := make([]models.TechniqueWithUsage, 0, len(techniques))
+	for _, technique := range techniques {
+		result = append(result, models.TechniqueWithUsage{MiniatureTechnique: technique, MiniatureCount: counts[technique.ID]})
+	}
+	return result, nil
+}
+
+func (r *repository) GetTechniqueMiniatures(ctx context.Context, id int64) (*models.TechniqueMiniatures, error) {
+	var technique models.MiniatureTechnique
+	if err := r.db.WithContext(ctx).First(&technique, id).Error; err != nil {
+		return nil, fmt.Errorf("failed to get technique by id %d: %w", id, err)
+	}
+
+	miniatures, total, err := r.ListMiniatureProjects(ctx, MiniatureFilter{TechniqueID: &id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniatures for technique %d: %w", id, err)
+	}
+
+	return &models.TechniqueMiniatures{
+		Technique:  models.TechniqueWithUsage{MiniatureTechnique: technique, MiniatureCount: total},
+		Miniatures: miniatures,
+	}, nil
+}
+
+// countUsage counts distinct miniatures per catalogue entry in a junction table
+func (r *repository) countUsage(ctx context.Context, table, column string) (map[int64]int64, error) {
+	var usage []catalogueUsage
+	err := r.db.WithContext(ctx).
+		Table(table).
+		Select(column + " AS id, COUNT(DISTINCT miniature_project_id) AS miniature_count").
+		Group(column).
+		Scan(&usage).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int64, len(usage))
+	for _, u := range usage {
+		counts[u.ID] = u.MiniatureCount
+	}
+	return counts, nil
+}
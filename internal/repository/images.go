@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	commonModels "github.com/GunarsK-portfolio/portfolio-common/models"
+	"github.com/GunarsK-portfolio/portfolio-common/utils"
+	"github.com/GunarsK-portfolio/public-api/internal/imaging"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxInspections bounds concurrent image inspections per call. On a cold cache
+// each one downloads and decodes a full image from the Files API.
+const maxInspections = 4
+
+// ImageInspector reports the details of the image stored under key
+type ImageInspector interface {
+	Inspect(ctx context.Context, key, url string) (imaging.Info, error)
+}
+
+// populateFiles builds the URLs of every stored file and, when an inspector is
+// set, the details and variants of every image. Images that cannot be
+// inspected keep their URLs and get neither.
+func (r *repository) populateFiles(ctx context.Context, files ...*models.StorageFile) {
+	for _, file := range files {
+		if file != nil && file.S3Key != "" {
+			file.SourceURL = utils.BuildFileURL(r.filesAPIURL, file.FileType, file.S3Key)
+			file.URL = r.publicFileURL(file)
+		}
+	}
+	if r.inspector == nil {
+		return
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxInspections)
+	for _, file := range files {
+		if file == nil || file.SourceURL == "" || !isImage(file) {
+			continue
+		}
+		g.Go(func() error {
+			info, err := r.inspector.Inspect(ctx, file.S3Key, file.SourceURL)
+			if err != nil {
+				return nil
+			}
+			file.ImageDetails = models.ImageDetails{
+				Width:         info.Width,
+				Height:        info.Height,
+				BlurHash:      info.BlurHash,
+				DominantColor: info.DominantColor,
+				CapturedAt:    info.CapturedAt,
+				Orientation:   info.Orientation,
+			}
+			file.Variants = r.imageVariants(file, info.Width, info.Height)
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// publicFileURL is where clients load a stored file. Images are served through
+// the image endpoint, which strips their EXIF and XMP metadata and applies
+// their orientation, and are never linked to the Files API directly.
+func (r *repository) publicFileURL(file *models.StorageFile) string {
+	if isImage(file) {
+		return fmt.Sprintf("%s/img/%d", r.imageAPIURL, file.ID)
+	}
+	return utils.BuildFileURL(r.filesAPIURL, file.FileType, file.S3Key)
+}
+
+func isImage(file *models.StorageFile) bool {
+	return strings.HasPrefix(file.MimeType, "image/")
+}
+
+// imageVariants lists the resized renditions of a stored image, each size in
+// every output format with the preferred format first. URLs carry the nominal
+// variant width, which the image endpoint caps at the original width.
+func (r *repository) imageVariants(file *models.StorageFile, width, height int) []models.ImageVariant {
+	sizes := imaging.Sizes(width, height)
+	formats := imaging.Formats(file.MimeType)
+	variants := make([]models.ImageVariant, 0, len(sizes)*len(formats))
+	for _, size := range sizes {
+		for _, format := range formats {
+			variants = append(variants, models.ImageVariant{
+				Name:   size.Variant.Name,
+				URL:    fmt.Sprintf("%s/img/%d?w=%d&fmt=%s", r.imageAPIURL, file.ID, size.Variant.Width, format),
+				Width:  size.Width,
+				Height: size.Height,
+				Format: format,
+				Type:   "image/" + format,
+			})
+		}
+	}
+	return variants
+}
+
+// populateMiniatureImages builds the frontend images of each miniature from its loaded files
+func (r *repository) populateMiniatureImages(ctx context.Context, miniatures ...*models.MiniatureProject) {
+	var files []*models.StorageFile
+	for _, miniature := range miniatures {
+		for i := range miniature.MiniatureFiles {
+			files = append(files, miniature.MiniatureFiles[i].File)
+		}
+	}
+	r.populateFiles(ctx, files...)
+
+	for _, miniature := range miniatures {
+		miniature.Images = make([]models.Image, 0, len(miniature.MiniatureFiles))
+		for _, file := range miniature.MiniatureFiles {
+			if file.File != nil {
+				miniature.Images = append(miniature.Images, models.Image{
+					Image:        commonModels.Image{ID: file.ID, URL: file.File.URL, Caption: file.Caption},
+					ImageDetails: file.File.ImageDetails,
+					Variants:     file.File.Variants,
+				})
+			}
+		}
+	}
+}
+
+// publicImageCondition restricts image lookups to files shown by published
+// content, so the image endpoint cannot be used to enumerate storage
+const publicImageCondition = `mime_type LIKE 'image/%' AND (
+	id IN (SELECT image_file_id FROM portfolio.portfolio_projects WHERE image_file_id IS NOT NULL)
+	OR id IN (SELECT avatar_file_id FROM portfolio.profile WHERE avatar_file_id IS NOT NULL)
+	OR id IN (SELECT cover_image_id FROM miniatures.miniature_themes WHERE cover_image_id IS NOT NULL)
+	OR id IN (SELECT file_id FROM miniatures.miniature_files)
+)`
+
+func (r *repository) GetImageFile(ctx context.Context, id int64) (*models.StorageFile, error) {
+	var file models.StorageFile
+	err := r.db.WithContext(ctx).
+		Where(publicImageCondition).
+		First(&file, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image file by id %d: %w", id, err)
+	}
+
+	file.SourceURL = utils.BuildFileURL(r.filesAPIURL, file.FileType, file.S3Key)
+	file.URL = r.publicFileURL(&file)
+	return &file, nil
+}
@@ -4,32 +4,120 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/GunarsK-portfolio/portfolio-common/utils"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
 	"gorm.io/gorm"
 )
 
+// defaultMiniatureOrder is the ordering used when no sort is requested
+const defaultMiniatureOrder = "display_order ASC, id ASC"
+
+// miniatureSortColumns maps public sort field names to database columns
+var miniatureSortColumns = map[string]string{
+	"title":         "title",
+	"scale":         "scale",
+	"difficulty":    "difficulty",
+	"displayOrder":  "display_order",
+	"completedDate": "completed_date",
+	"createdAt":     "created_at",
+	"updatedAt":     "updated_at",
+}
+
+// MiniatureFilter narrows and orders the miniature project list.
+// Zero values mean "no filter" for every field.
+type MiniatureFilter struct {
+	ThemeID      *int64
+	Scale        string
+	Manufacturer string
+	Difficulty   string
+	TechniqueID  *int64
+	PaintID      *int64
+	PaintIDs     []int64 // Miniatures using any of these paints
+	Sort         string  // Comma-separated fields, "-" prefix for descending
+	Pagination
+}
+
 func (r *repository) GetAllMiniatureProjects(ctx context.Context) ([]models.MiniatureProject, error) {
+	projects, _, err := r.ListMiniatureProjects(ctx, MiniatureFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all miniature projects: %w", err)
+	}
+	return projects, nil
+}
+
+func (r *repository) ListMiniatureProjects(ctx context.Context, filter MiniatureFilter) ([]models.MiniatureProject, int64, error) {
+	order, err := buildOrder(filter.Sort, miniatureSortColumns, defaultMiniatureOrder)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := filterMiniatures(r.db.WithContext(ctx).Model(&models.MiniatureProject{}), filter)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count miniature projects: %w", err)
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit).Offset(filter.Offset())
+	}
+
 	var projects []models.MiniatureProject
-	err := r.db.WithContext(ctx).
+	err = query.
 		Preload("MiniatureFiles", func(db *gorm.DB) *gorm.DB {
 			return db.Order("miniatures.miniature_files.display_order ASC, miniatures.miniature_files.id ASC")
 		}).
 		Preload("MiniatureFiles.File").
 		Preload("Techniques.Technique").
 		Preload("Paints.Paint").
-		Order("display_order ASC, id ASC").
+		Order(order).
 		Find(&projects).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all miniature projects: %w", err)
+		return nil, 0, fmt.Errorf("failed to list miniature projects: %w", err)
 	}
 
 	// Convert MiniatureFiles to Images for frontend
+	miniatures := make([]*models.MiniatureProject, len(projects))
 	for i := range projects {
-		projects[i].Images = utils.ConvertMiniatureFilesToImages(projects[i].MiniatureFiles, r.filesAPIURL)
+		miniatures[i] = &projects[i]
 	}
+	r.populateMiniatureImages(ctx, miniatures...)
 
-	return projects, nil
+	return projects, total, nil
+}
+
+// filterMiniatures applies the WHERE conditions of a MiniatureFilter to the query
+func filterMiniatures(query *gorm.DB, filter MiniatureFilter) *gorm.DB {
+	if filter.ThemeID != nil {
+		query = query.Where("theme_id = ?", *filter.ThemeID)
+	}
+	if filter.Scale != "" {
+		query = query.Where("LOWER(scale) = LOWER(?)", filter.Scale)
+	}
+	if filter.Manufacturer != "" {
+		query = query.Where("LOWER(manufacturer) = LOWER(?)", filter.Manufacturer)
+	}
+	if filter.Difficulty != "" {
+		query = query.Where("LOWER(difficulty) = LOWER(?)", filter.Difficulty)
+	}
+	if filter.TechniqueID != nil {
+		query = query.Where(
+			"id IN (SELECT miniature_project_id FROM miniatures.miniature_techniques WHERE technique_id = ?)",
+			*filter.TechniqueID,
+		)
+	}
+	if filter.PaintID != nil {
+		query = query.Where(
+			"id IN (SELECT miniature_project_id FROM miniatures.miniature_paints WHERE paint_id = ?)",
+			*filter.PaintID,
+		)
+	}
+	if len(filter.PaintIDs) > 0 {
+		query = query.Where(
+			"id IN (SELECT miniature_project_id FROM miniatures.miniature_paints WHERE paint_id IN ?)",
+			filter.PaintIDs,
+		)
+	}
+	return query
 }
 
 func (r *repository) GetMiniatureProjectByID(ctx context.Context, id int64) (*models.MiniatureProject, error) {
@@ -48,7 +136,7 @@ func (r *repository) GetMiniatureProjectByID(ctx context.Context, id int64) (*mo
 	}
 
 	// Convert MiniatureFiles to Images for frontend
-	project.Images = utils.ConvertMiniatureFilesToImages(project.MiniatureFiles, r.filesAPIURL)
+	r.populateMiniatureImages(ctx, &project)
 
 	return &project, nil
 }
@@ -64,9 +152,11 @@ func (r *repository) GetAllMiniatureThemes(ctx context.Context) ([]models.Miniat
 	}
 
 	// Populate cover image URLs
+	covers := make([]*models.StorageFile, len(themes))
 	for i := range themes {
-		utils.PopulateFileURL(themes[i].CoverImageFile, r.filesAPIURL)
+		covers[i] = themes[i].CoverImageFile
 	}
+	r.populateFiles(ctx, covers...)
 
 	return themes, nil
 }
@@ -90,12 +180,14 @@ func (r *repository) GetMiniatureThemeByID(ctx context.Context, id int64) (*mode
 	}
 
 	// Populate cover image URL
-	utils.PopulateFileURL(theme.CoverImageFile, r.filesAPIURL)
+	r.populateFiles(ctx, theme.CoverImageFile)
 
 	// Convert MiniatureFiles to Images for each miniature
+	miniatures := make([]*models.MiniatureProject, len(theme.Miniatures))
 	for i := range theme.Miniatures {
-		theme.Miniatures[i].Images = utils.ConvertMiniatureFilesToImages(theme.Miniatures[i].MiniatureFiles, r.filesAPIURL)
+		miniatures[i] = &theme.Miniatures[i]
 	}
+	r.populateMiniatureImages(ctx, miniatures...)
 
 	return &theme, nil
 }
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// Weights of what a related miniature has in common with the current one.
+// Sharing a theme counts for more than any single technique or paint.
+const (
+	relatedThemeWeight     = 3
+	relatedTechniqueWeight = 1
+	relatedPaintWeight     = 1
+)
+
+// relatedProjectsQuery ranks other projects by the number of technologies they
+// share with the project, breaking ties with the default list order
+const relatedProjectsQuery = `
+SELECT p.id, COUNT(*) AS score
+FROM portfolio.project_technologies AS mine
+JOIN portfolio.project_technologies AS theirs
+  ON theirs.skill_id = mine.skill_id AND theirs.project_id <> mine.project_id
+JOIN portfolio.portfolio_projects AS p ON p.id = theirs.project_id
+WHERE mine.project_id = @id
+GROUP BY p.id, p.featured, p.display_order, p.start_date
+ORDER BY score DESC, p.featured DESC, p.display_order ASC, p.start_date DESC, p.id ASC
+LIMIT @limit`
+
+// relatedMiniaturesQuery ranks other miniatures by a weighted count of the
+// theme, techniques and paints they share with the miniature. The weights are
+// cast because Postgres resolves untyped parameters in a UNION as text.
+const relatedMiniaturesQuery = `
+WITH shared AS (
+  SELECT other.id AS miniature_id, CAST(@themeWeight AS integer) AS weight
+  FROM miniatures.miniature_projects AS mine
+  JOIN miniatures.miniature_projects AS other
+    ON other.theme_id = mine.theme_id AND other.id <> mine.id
+  WHERE mine.id = @id
+  UNION ALL
+  SELECT theirs.miniature_project_id, CAST(@techniqueWeight AS integer)
+  FROM miniatures.miniature_techniques AS mine
+  JOIN miniatures.miniature_techniques AS theirs
+    ON theirs.technique_id = mine.technique_id AND theirs.miniature_project_id <> mine.miniature_project_id
+  WHERE mine.miniature_project_id = @id
+  UNION ALL
+  SELECT theirs.miniature_project_id, CAST(@paintWeight AS integer)
+  FROM miniatures.miniature_paints AS mine
+  JOIN miniatures.miniature_paints AS theirs
+    ON theirs.paint_id = mine.paint_id AND theirs.miniature_project_id <> mine.miniature_project_id
+  WHERE mine.miniature_project_id = @id
+)
+SELECT m.id, SUM(shared.weight) AS score
+FROM shared
+JOIN miniatures.miniature_projects AS m ON m.id = shared.miniature_id
+GROUP BY m.id, m.display_order
+ORDER BY score DESC, m.display_order ASC, m.id ASC
+LIMIT @limit`
+
+// scoredID is one row of a related items query
+type scoredID struct {
+	ID    int64
+	Score int
+}
+
+func (r *repository) GetProjectNavigation(ctx context.Context, id int64, filter ProjectFilter, relatedLimit int) (*models.Navigation, error) {
+	order, err := buildOrder(filter.Sort, projectSortColumns, defaultProjectOrder)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.WithContext(ctx).Select("id").First(&models.PortfolioProject{}, id).Error; err != nil {
+		return nil, fmt.Errorf("failed to get project navigation for id %d: %w", id, err)
+	}
+
+	var ordered []int64
+	err = r.filterProjects(r.db.WithContext(ctx).Model(&models.PortfolioProject{}), filter).
+		Order(order).
+		Pluck("id", &ordered).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project order: %w", err)
+	}
+
+	var related []scoredID
+	if relatedLimit > 0 {
+		err = r.db.WithContext(ctx).
+			Raw(relatedProjectsQuery, map[string]any{"id": id, "limit": relatedLimit}).
+			Scan(&related).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get related projects: %w", err)
+		}
+	}
+
+	previous, next := neighbours(ordered, id)
+	items, err := r.projectNavItems(ctx, navIDs(previous, next, related))
+	if err != nil {
+		return nil, err
+	}
+	return buildNavigation(items, previous, next, related), nil
+}
+
+func (r *repository) GetMiniatureNavigation(ctx context.Context, id int64, filter MiniatureFilter, relatedLimit int) (*models.Navigation, error) {
+	order, err := buildOrder(filter.Sort, miniatureSortColumns, defaultMiniatureOrder)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.WithContext(ctx).Select("id").First(&models.MiniatureProject{}, id).Error; err != nil {
+		return nil, fmt.Errorf("failed to get miniature navigation for id %d: %w", id, err)
+	}
+
+	var ordered []int64
+	err = filterMiniatures(r.db.WithContext(ctx).Model(&models.MiniatureProject{}), filter).
+		Order(order).
+		Pluck("id", &ordered).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniature order: %w", err)
+	}
+
+	var related []scoredID
+	if relatedLimit > 0 {
+		if related, err = r.relatedMiniatures(ctx, id, relatedLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	previous, next := neighbours(ordered, id)
+	items, err := r.miniatureNavItems(ctx, navIDs(previous, next, related))
+	if err != nil {
+		return nil, err
+	}
+	return buildNavigation(items, previous, next, related), nil
+}
+
+// relatedMiniatures ranks up to limit miniatures by what they share with id
+func (r *repository) relatedMiniatures(ctx context.Context, id int64, limit int) ([]scoredID, error) {
+	var related []scoredID
+	err := r.db.WithContext(ctx).
+		Raw(relatedMiniaturesQuery, map[string]any{
+			"id":              id,
+			"limit":           limit,
+			"themeWeight":     relatedThemeWeight,
+			"techniqueWeight": relatedTechniqueWeight,
+			"paintWeight":     relatedPaintWeight,
+		}).
+		Scan(&related).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get related miniatures: %w", err)
+	}
+	return related, nil
+}
+
+// projectNavItems loads link summaries of the given projects
+func (r *repository) projectNavItems(ctx context.Context, ids []int64) (map[int64]models.NavItem, error) {
+	items := make(map[int64]models.NavItem, len(ids))
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	var projects []models.PortfolioProject
+	err := r.db.WithContext(ctx).
+		Select("id, title, image_file_id").
+		Preload("ImageFile").
+		Where("id IN ?", ids).
+		Find(&projects).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project summaries: %w", err)
+	}
+	slugs, err := r.GetProjectSlugs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range projects {
+		item := models.NavItem{ID: project.ID, Slug: slugs[project.ID], Title: project.Title}
+		if project.ImageFile != nil {
+			item.Image = r.publicFileURL(project.ImageFile)
+		}
+		items[project.ID] = item
+	}
+	return items, nil
+}
+
+// miniatureNavItems loads link summaries of the given miniatures, using the first image
+func (r *repository) miniatureNavItems(ctx context.Context, ids []int64) (map[int64]models.NavItem, error) {
+	items := make(map[int64]models.NavItem, len(ids))
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	var miniatures []models.MiniatureProject
+	err := r.db.WithContext(ctx).
+		Select("id, title").
+		Preload("MiniatureFiles", func(db *gorm.DB) *gorm.DB {
+			return db.Order("miniatures.miniature_files.display_order ASC, miniatures.miniature_files.id ASC")
+		}).
+		Preload("MiniatureFiles.File").
+		Where("id IN ?", ids).
+		Find(&miniatures).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniature summaries: %w", err)
+	}
+	slugs, err := r.GetMiniatureSlugs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, miniature := range miniatures {
+		item := models.NavItem{ID: miniature.ID, Slug: slugs[miniature.ID], Title: miniature.Title}
+		for _, file := range miniature.MiniatureFiles {
+			if file.File != nil {
+				item.Image = r.publicFileURL(file.File)
+				break
+			}
+		}
+		items[miniature.ID] = item
+	}
+	return items, nil
+}
+
+// neighbours returns the IDs before and after id in ordered, or 0 at either end.
+// Both are 0 when id is not part of the (filtered) list.
+func neighbours(ordered []int64, id int64) (previous, next int64) {
+	i := slices.Index(ordered, id)
+	if i < 0 {
+		return 0, 0
+	}
+	if i > 0 {
+		previous = ordered[i-1]
+	}
+	if i < len(ordered)-1 {
+		next = ordered[i+1]
+	}
+	return previous, next
+}
+
+// navIDs lists every ID whose summary a navigation needs
+func navIDs(previous, next int64, related []scoredID) []int64 {
+	ids := make([]int64, 0, len(related)+2)
+	for _, id := range []int64{previous, next} {
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	for _, item := range related {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func buildNavigation(items map[int64]models.NavItem, previous, next int64, related []scoredID) *models.Navigation {
+	nav := &models.Navigation{Related: make([]models.RelatedItem, 0, len(related))}
+	if item, ok := items[previous]; ok {
+		nav.Previous = &item
+	}
+	if item, ok := items[next]; ok {
+		nav.Next = &item
+	}
+	for _, scored := range related {
+		if item, ok := items[scored.ID]; ok {
+			nav.Related = append(nav.Related, models.RelatedItem{NavItem: item, Score: scored.Score})
+		}
+	}
+	return nav
+}
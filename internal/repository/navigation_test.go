@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// openTestDB connects to the scratch database named by TEST_DATABASE_URL and
+// returns a transaction that is rolled back when the test ends. Tests that
+// need Postgres are skipped when the variable is unset.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		t.Fatalf("failed to begin transaction: %v", tx.Error)
+	}
+	t.Cleanup(func() { tx.Rollback() })
+	return tx
+}
+
+// exec runs fixture statements, failing the test on the first error
+func exec(t *testing.T, db *gorm.DB, statements ...string) {
+	t.Helper()
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("fixture %q failed: %v", statement, err)
+		}
+	}
+}
+
+func TestRelatedMiniatures(t *testing.T) {
+	db := openTestDB(t)
+
+	// The tables are created when the database is empty. On a migrated
+	// database the existing ones are used, and the rows below are rolled back.
+	exec(t, db,
+		`CREATE SCHEMA IF NOT EXISTS miniatures`,
+		`CREATE TABLE IF NOT EXISTS miniatures.miniature_themes (id bigint PRIMARY KEY, name text NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS miniatures.miniature_projects (
+			id bigint PRIMARY KEY, title text NOT NULL, theme_id bigint, display_order integer NOT NULL DEFAULT 0)`,
+		`CREATE TABLE IF NOT EXISTS miniatures.cl_techniques (id bigint PRIMARY KEY, name text NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS miniatures.cl_paints (id bigint PRIMARY KEY, name text NOT NULL, manufacturer text NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS miniatures.miniature_techniques (miniature_project_id bigint NOT NULL, technique_id bigint NOT NULL)`,
+		`CREATE TABLE IF NOT EXISTS miniatures.miniature_paints (miniature_project_id bigint NOT NULL, paint_id bigint NOT NULL)`,
+
+		`INSERT INTO miniatures.miniature_themes (id, name) VALUES (900001, 'Blood Angels')`,
+		`INSERT INTO miniatures.cl_techniques (id, name) VALUES (900001, 'Edge Highlighting')`,
+		`INSERT INTO miniatures.cl_paints (id, name, manufacturer) VALUES (900001, 'Mephiston Red', 'Citadel'), (900002, 'Abaddon Black', 'Citadel')`,
+		`INSERT INTO miniatures.miniature_projects (id, title, theme_id, display_order) VALUES
+			(900001, 'Dante', 900001, 1),
+			(900002, 'Sanguinary Guard', 900001, 2),
+			(900003, 'Ork Boy', NULL, 3),
+			(900004, 'Intercessor', NULL, 4),
+			(900005, 'Unrelated', NULL, 5)`,
+		`INSERT INTO miniatures.miniature_techniques (miniature_project_id, technique_id) VALUES
+			(900001, 900001), (900003, 900001), (900004, 900001)`,
+		`INSERT INTO miniatures.miniature_paints (miniature_project_id, paint_id) VALUES
+			(900001, 900001), (900001, 900002), (900003, 900001), (900003, 900002)`,
+	)
+
+	r := &repository{db: db}
+	related, err := r.relatedMiniatures(context.Background(), 900001, 10)
+	if err != nil {
+		t.Fatalf("relatedMiniatures() error = %v", err)
+	}
+
+	// The shared theme scores as much as a technique and two paints together,
+	// so the tie is broken by display order
+	want := []scoredID{{ID: 900002, Score: 3}, {ID: 900003, Score: 3}, {ID: 900004, Score: 1}}
+	if len(related) != len(want) {
+		t.Fatalf("related = %+v, want %+v", related, want)
+	}
+	for i := range want {
+		if related[i] != want[i] {
+			t.Errorf("related[%d] = %+v, want %+v", i, related[i], want[i])
+		}
+	}
+}
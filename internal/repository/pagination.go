@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidSort is returned when a sort parameter references a field that is not allowed
+var ErrInvalidSort = errors.New("invalid sort field")
+
+// Pagination describes the requested page window.
+// A zero Limit disables paging and returns every matching row.
+type Pagination struct {
+	Page  int
+	Limit int
+}
+
+// Offset returns the number of rows to skip for the requested page
+func (p Pagination) Offset() int {
+	if p.Page <= 1 || p.Limit <= 0 {
+		return 0
+	}
+	return (p.Page - 1) * p.Limit
+}
+
+// buildOrder converts a comma-separated sort expression (e.g. "-startDate,title")
+// into an ORDER BY clause using only columns from the allowed map.
+// A leading "-" sorts the field descending. An empty expression returns fallback.
+func buildOrder(sort string, allowed map[string]string, fallback string) (string, error) {
+	if strings.TrimSpace(sort) == "" {
+		return fallback, nil
+	}
+
+	parts := strings.Split(sort, ",")
+	clauses := make([]string, 0, len(parts))
+	for _, part := range parts {
+		field := strings.TrimSpace(part)
+		direction := "ASC"
+		if strings.HasPrefix(field, "-") {
+			field = strings.TrimPrefix(field, "-")
+			direction = "DESC"
+		}
+
+		column, ok := allowed[field]
+		if !ok {
+			return "", fmt.Errorf("%w: %q", ErrInvalidSort, field)
+		}
+		clauses = append(clauses, column+" "+direction)
+	}
+
+	// Stable tiebreaker so pages never overlap
+	clauses = append(clauses, "id ASC")
+	return strings.Join(clauses, ", "), nil
+}
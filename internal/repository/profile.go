@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/GunarsK-portfolio/portfolio-common/utils"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
 )
 
@@ -18,9 +17,7 @@ func (r *repository) GetProfile(ctx context.Context) (*models.Profile, error) {
 		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
 
-	// Populate file URLs using helper
-	utils.PopulateFileURL(profile.AvatarFile, r.filesAPIURL)
-	utils.PopulateFileURL(profile.ResumeFile, r.filesAPIURL)
+	r.populateFiles(ctx, profile.AvatarFile, profile.ResumeFile)
 
 	return &profile, nil
 }
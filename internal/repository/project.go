@@ -3,29 +3,81 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/GunarsK-portfolio/portfolio-common/utils"
 	"github.com/GunarsK-portfolio/public-api/internal/models"
 	"gorm.io/gorm"
 )
 
+// defaultProjectOrder is the ordering used when no sort is requested
+const defaultProjectOrder = "featured DESC, display_order ASC, start_date DESC, id ASC"
+
+// projectSortColumns maps public sort field names to database columns
+var projectSortColumns = map[string]string{
+	"title":        "title",
+	"category":     "category",
+	"featured":     "featured",
+	"displayOrder": "display_order",
+	"startDate":    "start_date",
+	"endDate":      "end_date",
+	"createdAt":    "created_at",
+	"updatedAt":    "updated_at",
+}
+
+// ProjectFilter narrows and orders the project list.
+// Zero values mean "no filter" for every field.
+type ProjectFilter struct {
+	Category   string
+	Featured   *bool
+	IsOngoing  *bool
+	Technology string // Skill ID or case-insensitive skill name
+	SkillID    *int64
+	Sort       string // Comma-separated fields, "-" prefix for descending
+	Pagination
+}
+
 func (r *repository) GetAllProjects(ctx context.Context) ([]models.PortfolioProject, error) {
+	projects, _, err := r.ListProjects(ctx, ProjectFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all projects: %w", err)
+	}
+	return projects, nil
+}
+
+func (r *repository) ListProjects(ctx context.Context, filter ProjectFilter) ([]models.PortfolioProject, int64, error) {
+	order, err := buildOrder(filter.Sort, projectSortColumns, defaultProjectOrder)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := r.filterProjects(r.db.WithContext(ctx).Model(&models.PortfolioProject{}), filter)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit).Offset(filter.Offset())
+	}
+
 	var projects []models.PortfolioProject
-	err := r.db.WithContext(ctx).
+	err = query.
 		Preload("ImageFile").
 		Preload("Technologies", func(db *gorm.DB) *gorm.DB {
 			return db.Preload("SkillType").Order("portfolio.skills.display_order ASC")
 		}).
-		Order("featured DESC, display_order ASC, start_date DESC").
+		Order(order).
 		Find(&projects).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all projects: %w", err)
+		return nil, 0, fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	// Populate Technology.Type field and image URLs
+	// Populate image URLs and Technology.Type field
+	files := make([]*models.StorageFile, 0, len(projects))
 	for i := range projects {
-		// Populate image URL using helper
-		utils.PopulateFileURL(projects[i].ImageFile, r.filesAPIURL)
+		files = append(files, projects[i].ImageFile)
 
 		// Populate technology types
 		for j := range projects[i].Technologies {
@@ -34,8 +86,39 @@ func (r *repository) GetAllProjects(ctx context.Context) ([]models.PortfolioProj
 			}
 		}
 	}
+	r.populateFiles(ctx, files...)
 
-	return projects, nil
+	return projects, total, nil
+}
+
+// filterProjects applies the WHERE conditions of a ProjectFilter to the query
+func (r *repository) filterProjects(query *gorm.DB, filter ProjectFilter) *gorm.DB {
+	if filter.Category != "" {
+		query = query.Where("LOWER(category) = LOWER(?)", filter.Category)
+	}
+	if filter.Featured != nil {
+		query = query.Where("featured = ?", *filter.Featured)
+	}
+	if filter.IsOngoing != nil {
+		query = query.Where("is_ongoing = ?", *filter.IsOngoing)
+	}
+	if technology := strings.TrimSpace(filter.Technology); technology != "" {
+		projectIDs := r.db.Table("portfolio.project_technologies AS pt").
+			Select("pt.project_id").
+			Joins("JOIN portfolio.skills AS s ON s.id = pt.skill_id")
+		if skillID, err := strconv.ParseInt(technology, 10, 64); err == nil {
+			projectIDs = projectIDs.Where("pt.skill_id = ?", skillID)
+		} else {
+			projectIDs = projectIDs.Where("LOWER(s.skill) = LOWER(?)", technology)
+		}
+		query = query.Where("id IN (?)", projectIDs)
+	}
+	if filter.SkillID != nil {
+		query = query.Where("id IN (?)", r.db.Table("portfolio.project_technologies").
+			Select("project_id").
+			Where("skill_id = ?", *filter.SkillID))
+	}
+	return query
 }
 
 func (r *repository) GetProjectByID(ctx context.Context, id int64) (*models.PortfolioProject, error) {
@@ -50,8 +133,7 @@ func (r *repository) GetProjectByID(ctx context.Context, id int64) (*models.Port
 		return nil, fmt.Errorf("failed to get project by id %d: %w", id, err)
 	}
 
-	// Populate image URL using helper
-	utils.PopulateFileURL(project.ImageFile, r.filesAPIURL)
+	r.populateFiles(ctx, project.ImageFile)
 
 	// Populate Technology.Type field
 	for j := range project.Technologies {
@@ -12,22 +12,62 @@ type Repository interface {
 	GetAllWorkExperience(ctx context.Context) ([]models.WorkExperience, error)
 	GetAllCertifications(ctx context.Context) ([]models.Certification, error)
 	GetAllSkills(ctx context.Context) ([]models.Skill, error)
+	GetSkillGroups(ctx context.Context) ([]models.SkillGroup, error)
+	GetSkillProjects(ctx context.Context, id int64) (*models.SkillProjects, error)
 	GetAllProjects(ctx context.Context) ([]models.PortfolioProject, error)
+	ListProjects(ctx context.Context, filter ProjectFilter) ([]models.PortfolioProject, int64, error)
 	GetProjectByID(ctx context.Context, id int64) (*models.PortfolioProject, error)
 	GetAllMiniatureProjects(ctx context.Context) ([]models.MiniatureProject, error)
+	ListMiniatureProjects(ctx context.Context, filter MiniatureFilter) ([]models.MiniatureProject, int64, error)
 	GetMiniatureProjectByID(ctx context.Context, id int64) (*models.MiniatureProject, error)
 	GetAllMiniatureThemes(ctx context.Context) ([]models.MiniatureTheme, error)
 	GetMiniatureThemeByID(ctx context.Context, id int64) (*models.MiniatureTheme, error)
+	GetAllPaints(ctx context.Context) ([]models.PaintWithUsage, error)
+	GetPaintMiniatures(ctx context.Context, id int64) (*models.PaintMiniatures, error)
+	GetAllTechniques(ctx context.Context) ([]models.TechniqueWithUsage, error)
+	GetTechniqueMiniatures(ctx context.Context, id int64) (*models.TechniqueMiniatures, error)
+	GetImageFile(ctx context.Context, id int64) (*models.StorageFile, error)
+	GetSitemapPages(ctx context.Context) (*models.SitemapPages, error)
+	GetProjectSlugs(ctx context.Context) (map[int64]string, error)
+	GetMiniatureSlugs(ctx context.Context) (map[int64]string, error)
+	GetThemeSlugs(ctx context.Context) (map[int64]string, error)
+	GetProjectBySlug(ctx context.Context, slug string) (*models.PortfolioProject, error)
+	GetMiniatureProjectBySlug(ctx context.Context, slug string) (*models.MiniatureProject, error)
+	GetMiniatureThemeBySlug(ctx context.Context, slug string) (*models.MiniatureTheme, error)
+	GetProjectNavigation(ctx context.Context, id int64, filter ProjectFilter, relatedLimit int) (*models.Navigation, error)
+	GetMiniatureNavigation(ctx context.Context, id int64, filter MiniatureFilter, relatedLimit int) (*models.Navigation, error)
+	Search(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error)
 }
 
 type repository struct {
 	db          *gorm.DB
 	filesAPIURL string
+	inspector   ImageInspector
+	imageAPIURL string
 }
 
-func New(db *gorm.DB, filesAPIURL string) Repository {
-	return &repository{
+// Option configures optional repository behaviour
+type Option func(*repository)
+
+// WithImageInspector adds intrinsic dimensions and placeholder data, as
+// reported by inspector, to every image the repository returns
+func WithImageInspector(inspector ImageInspector) Option {
+	return func(r *repository) {
+		r.inspector = inspector
+	}
+}
+
+// New creates a repository that loads stored files from the Files API at
+// filesAPIURL and publishes images through the image endpoint of the API at
+// apiURL, so clients never get an original with its EXIF and XMP metadata
+func New(db *gorm.DB, filesAPIURL, apiURL string, opts ...Option) Repository {
+	r := &repository{
 		db:          db,
 		filesAPIURL: filesAPIURL,
+		imageAPIURL: apiURL,
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	return r
 }
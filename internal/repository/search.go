@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+)
+
+// searchConfig is the PostgreSQL text search configuration used for stemming
+const searchConfig = "english"
+
+// Control characters mark highlights inside ts_headline output so the text
+// can be HTML-escaped before the markers are turned into <mark> tags
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+// searchSources holds one SELECT per models.SearchTypes entry. Every query computes its
+// tsvector on the fly, so search needs no schema changes and works with the
+// read-only database user. Each returns type, id, title, snippet and rank
+// against the "q" CTE holding the parsed websearch query.
+var searchSources = map[string]string{
+	models.SearchTypeProject: `
+		SELECT 'project' AS type, p.id, p.title,
+			ts_headline(@config, coalesce(nullif(p.description, ''), p.long_description, ''), q.query, @options) AS snippet,
+			ts_rank(
+				setweight(to_tsvector(@config, coalesce(p.title, '')), 'A') ||
+				setweight(to_tsvector(@config, coalesce(p.description, '')), 'B') ||
+				setweight(to_tsvector(@config, coalesce(p.long_description, '')), 'C'),
+				q.query) AS rank
+		FROM portfolio.portfolio_projects p, q
+		WHERE (
+			to_tsvector(@config, coalesce(p.title, '')) ||
+			to_tsvector(@config, coalesce(p.description, '')) ||
+			to_tsvector(@config, coalesce(p.long_description, ''))
+		) @@ q.query`,
+	models.SearchTypeExperience: `
+		SELECT 'experience' AS type, e.id, e.position || ' at ' || e.company AS title,
+			ts_headline(@config, coalesce(e.description, ''), q.query, @options) AS snippet,
+			ts_rank(
+				setweight(to_tsvector(@config, coalesce(e.company, '')), 'A') ||
+				setweight(to_tsvector(@config, coalesce(e.position, '')), 'A') ||
+				setweight(to_tsvector(@config, coalesce(e.description, '')), 'C'),
+				q.query) AS rank
+		FROM portfolio.work_experience e, q
+		WHERE (
+			to_tsvector(@config, coalesce(e.company, '')) ||
+			to_tsvector(@config, coalesce(e.position, '')) ||
+			to_tsvector(@config, coalesce(e.description, ''))
+		) @@ q.query`,
+	models.SearchTypeSkill: `
+		SELECT 'skill' AS type, s.id, s.skill AS title,
+			ts_headline(@config, s.skill, q.query, @options) AS snippet,
+			ts_rank(setweight(to_tsvector(@config, s.skill), 'A'), q.query) AS rank
+		FROM portfolio.skills s, q
+		WHERE s.is_visible = TRUE AND to_tsvector(@config, s.skill) @@ q.query`,
+	models.SearchTypeMiniature: `
+		SELECT 'miniature' AS type, m.id, m.title,
+			ts_headline(@config, coalesce(m.description, ''), q.query, @options) AS snippet,
+			ts_rank(
+				setweight(to_tsvector(@config, coalesce(m.title, '')), 'A') ||
+				setweight(to_tsvector(@config, coalesce(m.description, '')), 'B'),
+				q.query) AS rank
+		FROM miniatures.miniature_projects m, q
+		WHERE (
+			to_tsvector(@config, coalesce(m.title, '')) ||
+			to_tsvector(@config, coalesce(m.description, ''))
+		) @@ q.query`,
+}
+
+func (r *repository) Search(ctx context.Context, query string, types []string, limit int) ([]models.SearchResult, error) {
+	wanted := make(map[string]bool, len(types))
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	selects := make([]string, 0, len(models.SearchTypes))
+	for _, t := range models.SearchTypes {
+		if len(wanted) == 0 || wanted[t] {
+			selects = append(selects, searchSources[t])
+		}
+	}
+
+	sql := "WITH q AS (SELECT websearch_to_tsquery(@config, @query) AS query) " +
+		"SELECT type, id, title, snippet, rank FROM (" +
+		strings.Join(selects, " UNION ALL ") +
+		") results ORDER BY rank DESC, type ASC, id ASC LIMIT @limit"
+
+	results := []models.SearchResult{}
+	err := r.db.WithContext(ctx).Raw(sql, map[string]any{
+		"config":  searchConfig,
+		"query":   query,
+		"limit":   limit,
+		"options": "StartSel=" + highlightStart + ", StopSel=" + highlightStop + ", MaxWords=35, MinWords=15, MaxFragments=2",
+	}).Scan(&results).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for %q: %w", query, err)
+	}
+
+	for i := range results {
+		results[i].Snippet = highlight(results[i].Snippet)
+	}
+
+	return results, nil
+}
+
+// highlight escapes a ts_headline snippet and converts the highlight markers to <mark> tags
+func highlight(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, highlightStart, "<mark>")
+	return strings.ReplaceAll(escaped, highlightStop, "</mark>")
+}
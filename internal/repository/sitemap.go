@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+)
+
+// GetSitemapPages loads only the ID and modification time of every project,
+// theme and miniature, in their default list order
+func (r *repository) GetSitemapPages(ctx context.Context) (*models.SitemapPages, error) {
+	var pages models.SitemapPages
+	for _, query := range []struct {
+		model any
+		order string
+		into  *[]models.PageStamp
+	}{
+		{&models.PortfolioProject{}, defaultProjectOrder, &pages.Projects},
+		{&models.MiniatureTheme{}, "display_order ASC, id ASC", &pages.Themes},
+		{&models.MiniatureProject{}, defaultMiniatureOrder, &pages.Miniatures},
+	} {
+		err := r.db.WithContext(ctx).
+			Model(query.model).
+			Select("id", "updated_at").
+			Order(query.order).
+			Scan(query.into).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sitemap pages: %w", err)
+		}
+	}
+	return &pages, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/GunarsK-portfolio/public-api/internal/models"
 )
@@ -27,3 +28,79 @@ func (r *repository) GetAllSkills(ctx context.Context) ([]models.Skill, error) {
 
 	return skills, nil
 }
+
+// skillUsage is one row of the per-skill project count query
+type skillUsage struct {
+	SkillID      int64
+	ProjectCount int64
+}
+
+func (r *repository) GetSkillGroups(ctx context.Context) ([]models.SkillGroup, error) {
+	skills, err := r.GetAllSkills(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []skillUsage
+	err = r.db.WithContext(ctx).
+		Table("portfolio.project_technologies").
+		Select("skill_id, COUNT(DISTINCT project_id) AS project_count").
+		Group("skill_id").
+		Scan(&usage).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count skill usage: %w", err)
+	}
+
+	counts := make(map[int64]int64, len(usage))
+	for _, u := range usage {
+		counts[u.SkillID] = u.ProjectCount
+	}
+
+	// Skills arrive ordered by type then display order, so appending keeps each group ordered
+	groups := []models.SkillGroup{}
+	index := make(map[int64]int)
+	for _, skill := range skills {
+		i, ok := index[skill.SkillTypeID]
+		if !ok {
+			group := models.SkillGroup{SkillType: models.SkillType{ID: skill.SkillTypeID}}
+			if skill.SkillType != nil {
+				group.SkillType = *skill.SkillType
+			}
+			i = len(groups)
+			index[skill.SkillTypeID] = i
+			groups = append(groups, group)
+		}
+		groups[i].Skills = append(groups[i].Skills, models.SkillWithUsage{
+			Skill:        skill,
+			ProjectCount: counts[skill.ID],
+		})
+	}
+
+	sort.SliceStable(groups, func(a, b int) bool {
+		return groups[a].DisplayOrder < groups[b].DisplayOrder
+	})
+
+	return groups, nil
+}
+
+func (r *repository) GetSkillProjects(ctx context.Context, id int64) (*models.SkillProjects, error) {
+	var skill models.Skill
+	err := r.db.WithContext(ctx).
+		Preload("SkillType").
+		Where("is_visible = ?", true).
+		First(&skill, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skill by id %d: %w", id, err)
+	}
+
+	if skill.SkillType != nil {
+		skill.Type = skill.SkillType.Name
+	}
+
+	projects, _, err := r.ListProjects(ctx, ProjectFilter{SkillID: &id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects for skill %d: %w", id, err)
+	}
+
+	return &models.SkillProjects{Skill: skill, Projects: projects}, nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/GunarsK-portfolio/public-api/internal/slug"
+	"gorm.io/gorm"
+)
+
+// Fallback slug prefixes for titles without any usable characters
+const (
+	projectSlugFallback   = "project"
+	miniatureSlugFallback = "miniature"
+	themeSlugFallback     = "theme"
+)
+
+func (r *repository) GetProjectSlugs(ctx context.Context) (map[int64]string, error) {
+	return r.slugs(ctx, &models.PortfolioProject{}, "title", projectSlugFallback)
+}
+
+func (r *repository) GetMiniatureSlugs(ctx context.Context) (map[int64]string, error) {
+	return r.slugs(ctx, &models.MiniatureProject{}, "title", miniatureSlugFallback)
+}
+
+func (r *repository) GetThemeSlugs(ctx context.Context) (map[int64]string, error) {
+	return r.slugs(ctx, &models.MiniatureTheme{}, "name", themeSlugFallback)
+}
+
+func (r *repository) GetProjectBySlug(ctx context.Context, value string) (*models.PortfolioProject, error) {
+	id, err := r.resolveSlug(ctx, r.GetProjectSlugs, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project by slug %q: %w", value, err)
+	}
+	return r.GetProjectByID(ctx, id)
+}
+
+func (r *repository) GetMiniatureProjectBySlug(ctx context.Context, value string) (*models.MiniatureProject, error) {
+	id, err := r.resolveSlug(ctx, r.GetMiniatureSlugs, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniature project by slug %q: %w", value, err)
+	}
+	return r.GetMiniatureProjectByID(ctx, id)
+}
+
+func (r *repository) GetMiniatureThemeBySlug(ctx context.Context, value string) (*models.MiniatureTheme, error) {
+	id, err := r.resolveSlug(ctx, r.GetThemeSlugs, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniature theme by slug %q: %w", value, err)
+	}
+	return r.GetMiniatureThemeByID(ctx, id)
+}
+
+// slugs derives the slug of every row from its title column. Slugs are not
+// stored, so they are recomputed from the (small) id and title projection.
+func (r *repository) slugs(ctx context.Context, model any, column, fallback string) (map[int64]string, error) {
+	var entries []slug.Entry
+	err := r.db.WithContext(ctx).
+		Model(model).
+		Select("id, " + column + " AS name").
+		Scan(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slugs: %w", err)
+	}
+	return slug.Assign(entries, fallback), nil
+}
+
+// resolveSlug finds the ID a slug belongs to, the current one or an older
+// form (see slug.Resolve), reporting gorm.ErrRecordNotFound when none has it
+func (r *repository) resolveSlug(ctx context.Context, load func(context.Context) (map[int64]string, error), value string) (int64, error) {
+	slugs, err := load(ctx)
+	if err != nil {
+		return 0, err
+	}
+	id, ok := slug.Resolve(slugs, value)
+	if !ok {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return id, nil
+}
@@ -0,0 +1,234 @@
+package resume
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// PDF layout, in millimetres and points
+const (
+	pdfFont       = "Go"
+	pdfMargin     = 18.0
+	pdfLineHeight = 5.0
+)
+
+// RenderPDF writes a single-column A4 PDF of the résumé.
+// The embedded Go fonts cover Latin Extended, so names with diacritics render correctly.
+// Output is deterministic for a given résumé: document dates come from meta.lastModified.
+func RenderPDF(w io.Writer, resume Resume) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(pdfFont, "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes(pdfFont, "B", gobold.TTF)
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.SetTitle(resume.Basics.Name+" - Résumé", true)
+	pdf.SetAuthor(resume.Basics.Name, true)
+	pdf.SetCreator("portfolio public-api", true)
+	pdf.SetCatalogSort(true)
+	if modified, err := time.Parse("2006-01-02T15:04:05", resume.Meta.LastModified); err == nil {
+		pdf.SetCreationDate(modified)
+		pdf.SetModificationDate(modified)
+	}
+	pdf.AddPage()
+
+	writeHeader(pdf, resume.Basics)
+
+	if len(resume.Work) > 0 {
+		writeSection(pdf, "Experience")
+		for _, work := range resume.Work {
+			writeEntry(pdf, work.Position+" – "+work.Name, dateRange(work.StartDate, work.EndDate), work.Summary)
+		}
+	}
+
+	if len(resume.Certificates) > 0 {
+		writeSection(pdf, "Certifications")
+		for _, cert := range resume.Certificates {
+			writeEntry(pdf, cert.Name+" – "+cert.Issuer, displayDate(cert.Date), "")
+		}
+	}
+
+	if len(resume.Skills) > 0 {
+		writeSection(pdf, "Skills")
+		for _, skill := range resume.Skills {
+			pdf.SetFont(pdfFont, "B", 10)
+			label := skill.Name + ": "
+			pdf.CellFormat(pdf.GetStringWidth(label), pdfLineHeight, label, "", 0, "L", false, 0, "")
+			pdf.SetFont(pdfFont, "", 10)
+			pdf.MultiCell(0, pdfLineHeight, strings.Join(skill.Keywords, ", "), "", "L", false)
+		}
+	}
+
+	if len(resume.Projects) > 0 {
+		writeSection(pdf, "Projects")
+		for _, project := range resume.Projects {
+			writeEntry(pdf, project.Name, dateRange(project.StartDate, project.EndDate), project.Description)
+			if len(project.Keywords) > 0 {
+				pdf.SetFont(pdfFont, "", 9)
+				pdf.SetTextColor(110, 110, 110)
+				pdf.MultiCell(0, pdfLineHeight, strings.Join(project.Keywords, " · "), "", "L", false)
+				pdf.SetTextColor(0, 0, 0)
+			}
+		}
+	}
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("failed to render resume PDF: %w", err)
+	}
+	return nil
+}
+
+func writeHeader(pdf *fpdf.Fpdf, basics Basics) {
+	pdf.SetFont(pdfFont, "B", 22)
+	pdf.MultiCell(0, 10, basics.Name, "", "L", false)
+
+	if basics.Label != "" {
+		pdf.SetFont(pdfFont, "", 13)
+		pdf.SetTextColor(80, 80, 80)
+		pdf.MultiCell(0, 7, basics.Label, "", "L", false)
+	}
+
+	contacts := []string{basics.Email, basics.Phone}
+	if basics.Location != nil {
+		contacts = append(contacts, strings.Trim(basics.Location.City+", "+basics.Location.Region, ", "))
+	}
+	for _, profile := range basics.Profiles {
+		contacts = append(contacts, profile.URL)
+	}
+	pdf.SetFont(pdfFont, "", 9)
+	pdf.SetTextColor(80, 80, 80)
+	pdf.MultiCell(0, pdfLineHeight, joinNonEmpty(contacts, "  ·  "), "", "L", false)
+	pdf.SetTextColor(0, 0, 0)
+
+	if basics.Summary != "" {
+		pdf.Ln(2)
+		pdf.SetFont(pdfFont, "", 10)
+		pdf.MultiCell(0, pdfLineHeight, basics.Summary, "", "L", false)
+	}
+}
+
+func writeSection(pdf *fpdf.Fpdf, title string) {
+	pdf.Ln(4)
+	pdf.SetFont(pdfFont, "B", 12)
+	pdf.CellFormat(0, 7, strings.ToUpper(title), "", 1, "L", false, 0, "")
+
+	pageWidth, _ := pdf.GetPageSize()
+	y := pdf.GetY()
+	pdf.SetDrawColor(180, 180, 180)
+	pdf.Line(pdfMargin, y, pageWidth-pdfMargin, y)
+	pdf.Ln(2)
+}
+
+// writeEntry prints a bold title with a right-aligned date column, followed by an optional body
+func writeEntry(pdf *fpdf.Fpdf, title, dates, body string) {
+	pdf.SetFont(pdfFont, "", 9)
+	datesWidth := pdf.GetStringWidth(dates) + 2
+
+	pdf.SetFont(pdfFont, "B", 10)
+	pageWidth, _ := pdf.GetPageSize()
+	titleWidth := pageWidth - 2*pdfMargin - datesWidth
+	lines := pdf.SplitText(title, titleWidth)
+	for i, line := range lines {
+		if i == 0 {
+			pdf.CellFormat(titleWidth, pdfLineHeight, line, "", 0, "L", false, 0, "")
+			pdf.SetFont(pdfFont, "", 9)
+			pdf.SetTextColor(80, 80, 80)
+			pdf.CellFormat(datesWidth, pdfLineHeight, dates, "", 1, "R", false, 0, "")
+			pdf.SetTextColor(0, 0, 0)
+			pdf.SetFont(pdfFont, "B", 10)
+			continue
+		}
+		pdf.CellFormat(titleWidth, pdfLineHeight, line, "", 1, "L", false, 0, "")
+	}
+
+	if body != "" {
+		pdf.SetFont(pdfFont, "", 10)
+		pdf.MultiCell(0, pdfLineHeight, body, "", "L", false)
+	}
+	pdf.Ln(2)
+}
+
+// displayDate formats a schema date (YYYY-MM-DD, YYYY-MM or YYYY) as "Jan 2006"
+func displayDate(value string) string {
+	for _, layout := range []string{"2006-01-02", "2006-01"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format("Jan 2006")
+		}
+	}
+	return value
+}
+
+func dateRange(start, end string) string {
+	if start == "" {
+		return displayDate(end)
+	}
+	if end == "" {
+		return displayDate(start) + " – Present"
+	}
+	return displayDate(start) + " – " + displayDate(end)
+}
+
+func joinNonEmpty(values []string, sep string) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// PDFCache keeps the most recently rendered PDF and renders again only when the
+// résumé content changes. Changes are detected by hashing the résumé's JSON form,
+// so any edit to the underlying data produces a new artifact without explicit invalidation.
+// The zero value is ready to use.
+type PDFCache struct {
+	mu     sync.Mutex
+	digest string
+	pdf    []byte
+
+	// render is swapped in tests to count renders
+	render func(io.Writer, Resume) error
+}
+
+// Get returns the PDF for resume, rendering it only if the content changed
+func (c *PDFCache) Get(resume Resume) ([]byte, error) {
+	encoded, err := json.Marshal(resume)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint resume: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	digest := hex.EncodeToString(sum[:16])
+
+	// Rendering under the lock also collapses concurrent renders of the same content
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pdf != nil && c.digest == digest {
+		return c.pdf, nil
+	}
+
+	render := c.render
+	if render == nil {
+		render = RenderPDF
+	}
+
+	var buf bytes.Buffer
+	if err := render(&buf, resume); err != nil {
+		return nil, err
+	}
+
+	c.digest = digest
+	c.pdf = buf.Bytes()
+	return c.pdf, nil
+}
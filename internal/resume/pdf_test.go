@@ -0,0 +1,54 @@
+package resume
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestRenderPDF(t *testing.T) {
+	resume := Build(testData())
+	resume.Basics.Name = "Jānis Bērziņš"
+
+	var first, second bytes.Buffer
+	if err := RenderPDF(&first, resume); err != nil {
+		t.Fatalf("RenderPDF() error = %v", err)
+	}
+	if err := RenderPDF(&second, resume); err != nil {
+		t.Fatalf("RenderPDF() error = %v", err)
+	}
+
+	if !bytes.HasPrefix(first.Bytes(), []byte("%PDF-")) {
+		t.Errorf("RenderPDF() output does not start with a PDF header")
+	}
+	if !bytes.Equal(first.Bytes(), second.Bytes()) {
+		t.Error("RenderPDF() output differs between renders of the same resume")
+	}
+}
+
+func TestPDFCache_RendersOnlyOnChange(t *testing.T) {
+	renders := 0
+	cache := &PDFCache{render: func(w io.Writer, r Resume) error {
+		renders++
+		_, err := io.WriteString(w, r.Basics.Name)
+		return err
+	}}
+
+	resume := Build(testData())
+	for i := 0; i < 3; i++ {
+		if _, err := cache.Get(resume); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+	if renders != 1 {
+		t.Errorf("rendered %d times for unchanged resume, want 1", renders)
+	}
+
+	resume.Work[0].Summary = "Updated"
+	if _, err := cache.Get(resume); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if renders != 2 {
+		t.Errorf("rendered %d times after a change, want 2", renders)
+	}
+}
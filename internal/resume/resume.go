@@ -0,0 +1,267 @@
+// Package resume maps portfolio content to the JSON Resume schema (https://jsonresume.org/schema).
+package resume
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+)
+
+const (
+	// SchemaURL identifies the JSON Resume schema version the document follows
+	SchemaURL = "https://raw.githubusercontent.com/jsonresume/resume-schema/v1.0.0/schema.json"
+
+	// SchemaVersion is reported in meta.version
+	SchemaVersion = "v1.0.0"
+)
+
+// Data is the portfolio content a résumé is built from
+type Data struct {
+	Profile        *models.Profile
+	Experience     []models.WorkExperience
+	Certifications []models.Certification
+	Skills         []models.Skill
+	Projects       []models.PortfolioProject
+}
+
+// Resume is a JSON Resume document. Sections the portfolio has no data for
+// (education, volunteer, awards, ...) are omitted.
+type Resume struct {
+	Schema       string        `json:"$schema"`
+	Basics       Basics        `json:"basics"`
+	Work         []Work        `json:"work"`
+	Certificates []Certificate `json:"certificates"`
+	Skills       []Skill       `json:"skills"`
+	Projects     []Project     `json:"projects"`
+	Meta         Meta          `json:"meta"`
+}
+
+type Basics struct {
+	Name     string    `json:"name"`
+	Label    string    `json:"label,omitempty"`
+	Image    string    `json:"image,omitempty"`
+	Email    string    `json:"email,omitempty"`
+	Phone    string    `json:"phone,omitempty"`
+	Summary  string    `json:"summary,omitempty"`
+	Location *Location `json:"location,omitempty"`
+	Profiles []Profile `json:"profiles"`
+}
+
+type Location struct {
+	City   string `json:"city,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+type Profile struct {
+	Network  string `json:"network"`
+	Username string `json:"username,omitempty"`
+	URL      string `json:"url"`
+}
+
+type Work struct {
+	Name      string `json:"name"`
+	Position  string `json:"position"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+	Summary   string `json:"summary,omitempty"`
+}
+
+type Certificate struct {
+	Name   string `json:"name"`
+	Date   string `json:"date,omitempty"`
+	Issuer string `json:"issuer"`
+	URL    string `json:"url,omitempty"`
+}
+
+type Skill struct {
+	Name     string   `json:"name"`
+	Keywords []string `json:"keywords"`
+}
+
+type Project struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Highlights  []string `json:"highlights,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	StartDate   string   `json:"startDate,omitempty"`
+	EndDate     string   `json:"endDate,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Type        string   `json:"type,omitempty"`
+}
+
+type Meta struct {
+	Version      string `json:"version"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// Build maps portfolio content to a JSON Resume document
+func Build(data Data) Resume {
+	resume := Resume{
+		Schema:       SchemaURL,
+		Work:         make([]Work, 0, len(data.Experience)),
+		Certificates: make([]Certificate, 0, len(data.Certifications)),
+		Skills:       []Skill{},
+		Projects:     make([]Project, 0, len(data.Projects)),
+		Meta:         Meta{Version: SchemaVersion},
+	}
+
+	var lastModified time.Time
+	touch := func(t time.Time) {
+		if t.After(lastModified) {
+			lastModified = t
+		}
+	}
+
+	if p := data.Profile; p != nil {
+		resume.Basics = buildBasics(p)
+		touch(p.UpdatedAt)
+	} else {
+		resume.Basics.Profiles = []Profile{}
+	}
+
+	for _, e := range data.Experience {
+		work := Work{
+			Name:      e.Company,
+			Position:  e.Position,
+			StartDate: date(e.StartDate),
+			Summary:   e.Description,
+		}
+		if e.EndDate != nil && !e.IsCurrent {
+			work.EndDate = date(*e.EndDate)
+		}
+		resume.Work = append(resume.Work, work)
+		touch(e.UpdatedAt)
+	}
+
+	for _, c := range data.Certifications {
+		resume.Certificates = append(resume.Certificates, Certificate{
+			Name:   c.Name,
+			Date:   date(c.IssueDate),
+			Issuer: c.Issuer,
+			URL:    c.CredentialURL,
+		})
+		touch(c.UpdatedAt)
+	}
+
+	// Skills arrive ordered by type, so each type becomes one keyword group
+	groups := make(map[string]int)
+	for _, s := range data.Skills {
+		name := s.Type
+		if name == "" {
+			name = "Other"
+		}
+		i, ok := groups[name]
+		if !ok {
+			i = len(resume.Skills)
+			groups[name] = i
+			resume.Skills = append(resume.Skills, Skill{Name: name, Keywords: []string{}})
+		}
+		resume.Skills[i].Keywords = append(resume.Skills[i].Keywords, s.Skill)
+		touch(s.UpdatedAt)
+	}
+
+	for _, p := range data.Projects {
+		resume.Projects = append(resume.Projects, buildProject(p))
+		touch(p.UpdatedAt)
+	}
+
+	if !lastModified.IsZero() {
+		resume.Meta.LastModified = lastModified.UTC().Format("2006-01-02T15:04:05")
+	}
+
+	return resume
+}
+
+func buildBasics(p *models.Profile) Basics {
+	basics := Basics{
+		Name:     p.FullName,
+		Label:    p.Title,
+		Email:    p.Email,
+		Phone:    p.Phone,
+		Summary:  p.Bio,
+		Profiles: []Profile{},
+	}
+	if p.AvatarFile != nil {
+		basics.Image = p.AvatarFile.URL
+	}
+
+	if p.Location != "" {
+		city, region, _ := strings.Cut(p.Location, ",")
+		basics.Location = &Location{
+			City:   strings.TrimSpace(city),
+			Region: strings.TrimSpace(region),
+		}
+	}
+
+	if p.Github != "" {
+		basics.Profiles = append(basics.Profiles, Profile{Network: "GitHub", Username: username(p.Github), URL: p.Github})
+	}
+	if p.Linkedin != "" {
+		basics.Profiles = append(basics.Profiles, Profile{Network: "LinkedIn", Username: username(p.Linkedin), URL: p.Linkedin})
+	}
+
+	return basics
+}
+
+func buildProject(p models.PortfolioProject) Project {
+	project := Project{
+		Name:        p.Title,
+		Description: p.Description,
+		Highlights:  p.Features,
+		Type:        p.Category,
+		URL:         p.LiveURL,
+	}
+	if project.URL == "" {
+		project.URL = p.GithubURL
+	}
+	if p.Role != "" {
+		project.Roles = []string{p.Role}
+	}
+	if p.StartDate != nil {
+		project.StartDate = date(*p.StartDate)
+	}
+	if p.EndDate != nil && !p.IsOngoing {
+		project.EndDate = date(*p.EndDate)
+	}
+	for _, t := range p.Technologies {
+		project.Keywords = append(project.Keywords, t.Skill)
+	}
+	return project
+}
+
+// dateLayouts are the stored date formats accepted by date, most specific first
+var dateLayouts = []string{time.RFC3339, "2006-01-02", "2006-01"}
+
+// date converts a stored date to the schema's ISO 8601 form (YYYY-MM-DD or YYYY-MM).
+// Unparseable values are dropped rather than failing schema validation.
+func date(value string) string {
+	value = strings.TrimSpace(value)
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if layout == "2006-01" {
+			return t.Format("2006-01")
+		}
+		return t.Format("2006-01-02")
+	}
+	return ""
+}
+
+// username returns the last path segment of a profile URL, e.g. "johndoe" for https://github.com/johndoe
+func username(profileURL string) string {
+	u, err := url.Parse(profileURL)
+	if err != nil {
+		return ""
+	}
+	name := path.Base(strings.TrimSuffix(u.Path, "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,167 @@
+package resume
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	commonModels "github.com/GunarsK-portfolio/portfolio-common/models"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// testdata/schema.json is a copy of the JSON Resume v1.0.0 schema
+func compileSchema(t *testing.T) *jsonschema.Schema {
+	t.Helper()
+
+	file, err := os.Open("testdata/schema.json")
+	if err != nil {
+		t.Fatalf("failed to open schema: %v", err)
+	}
+	defer file.Close()
+
+	doc, err := jsonschema.UnmarshalJSON(file)
+	if err != nil {
+		t.Fatalf("failed to parse schema: %v", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+	if err := compiler.AddResource(SchemaURL, doc); err != nil {
+		t.Fatalf("failed to add schema: %v", err)
+	}
+	schema, err := compiler.Compile(SchemaURL)
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+	return schema
+}
+
+func validate(t *testing.T, schema *jsonschema.Schema, resume Resume) {
+	t.Helper()
+
+	encoded, err := json.Marshal(resume)
+	if err != nil {
+		t.Fatalf("failed to marshal resume: %v", err)
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatalf("failed to parse resume: %v", err)
+	}
+	if err := schema.Validate(instance); err != nil {
+		t.Errorf("resume does not match JSON Resume schema: %v\n%s", err, encoded)
+	}
+}
+
+func testData() Data {
+	updated := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	endDate := "2022-12-31"
+	projectStart := "2023-01-15T00:00:00Z"
+	languages := &models.SkillType{ID: 1, Name: "Languages"}
+
+	return Data{
+		Profile: &models.Profile{
+			Profile: commonModels.Profile{
+				FullName:  "John Doe",
+				Title:     "Senior Software Engineer",
+				Bio:       "Builds things.",
+				Email:     "john@example.com",
+				Location:  "Riga, Latvia",
+				Github:    "https://github.com/johndoe",
+				Linkedin:  "https://www.linkedin.com/in/johndoe/",
+				UpdatedAt: updated.Add(-time.Hour),
+			},
+			AvatarFile: &models.StorageFile{
+				StorageFile: commonModels.StorageFile{
+					URL: "http://files/avatars/john.jpg",
+				},
+			},
+		},
+		Experience: []models.WorkExperience{
+			{Company: "Acme Corp", Position: "Engineer", StartDate: "2020-02-01", EndDate: &endDate, UpdatedAt: updated},
+			{Company: "Globex", Position: "Lead", StartDate: "2023-01-01", IsCurrent: true},
+		},
+		Certifications: []models.Certification{
+			{Name: "AWS Solutions Architect", Issuer: "Amazon Web Services", IssueDate: "2021-06-15", CredentialURL: "https://aws.example.com/cert/1"},
+		},
+		Skills: []models.Skill{
+			{ID: 1, Skill: "Go", Type: "Languages", SkillType: languages},
+			{ID: 2, Skill: "TypeScript", Type: "Languages", SkillType: languages},
+			{ID: 3, Skill: "PostgreSQL", Type: "Databases"},
+		},
+		Projects: []models.PortfolioProject{
+			{
+				PortfolioProject: commonModels.PortfolioProject{
+					Title:        "Portfolio",
+					Description:  "Personal site",
+					Category:     "application",
+					GithubURL:    "https://github.com/johndoe/portfolio",
+					StartDate:    &projectStart,
+					IsOngoing:    true,
+					Role:         "Author",
+					Features:     []string{"Search"},
+					Technologies: []models.Skill{{Skill: "Go"}},
+				},
+			},
+		},
+	}
+}
+
+func TestBuild_MatchesSchema(t *testing.T) {
+	schema := compileSchema(t)
+
+	validate(t, schema, Build(testData()))
+	validate(t, schema, Build(Data{}))
+}
+
+func TestBuild_MapsContent(t *testing.T) {
+	resume := Build(testData())
+
+	if resume.Basics.Name != "John Doe" || resume.Basics.Image != "http://files/avatars/john.jpg" {
+		t.Errorf("basics = %+v, want name and avatar image", resume.Basics)
+	}
+	if resume.Basics.Location == nil || resume.Basics.Location.City != "Riga" || resume.Basics.Location.Region != "Latvia" {
+		t.Errorf("location = %+v, want Riga/Latvia", resume.Basics.Location)
+	}
+	if len(resume.Basics.Profiles) != 2 || resume.Basics.Profiles[1].Username != "johndoe" {
+		t.Errorf("profiles = %+v, want GitHub and LinkedIn with usernames", resume.Basics.Profiles)
+	}
+
+	if resume.Work[0].EndDate != "2022-12-31" || resume.Work[1].EndDate != "" {
+		t.Errorf("work end dates = %q/%q, want 2022-12-31 and none for current role", resume.Work[0].EndDate, resume.Work[1].EndDate)
+	}
+
+	if len(resume.Skills) != 2 || resume.Skills[0].Name != "Languages" || len(resume.Skills[0].Keywords) != 2 {
+		t.Errorf("skills = %+v, want Languages (2) and Databases (1)", resume.Skills)
+	}
+
+	project := resume.Projects[0]
+	if project.StartDate != "2023-01-15" || project.URL != "https://github.com/johndoe/portfolio" || project.Keywords[0] != "Go" {
+		t.Errorf("project = %+v, want normalized date, GitHub fallback URL and keywords", project)
+	}
+
+	if resume.Meta.LastModified != "2024-03-01T12:30:00" {
+		t.Errorf("lastModified = %q, want newest UpdatedAt", resume.Meta.LastModified)
+	}
+}
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"2024-05-17", "2024-05-17"},
+		{"2024-05-17T00:00:00Z", "2024-05-17"},
+		{"2024-05", "2024-05"},
+		{"", ""},
+		{"May 2024", ""},
+	}
+
+	for _, tt := range tests {
+		if got := date(tt.in); got != tt.want {
+			t.Errorf("date(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/GunarsK-portfolio/public-api/docs"
 	"github.com/GunarsK-portfolio/public-api/internal/config"
 	"github.com/GunarsK-portfolio/public-api/internal/handlers"
+	"github.com/GunarsK-portfolio/public-api/internal/middleware"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
@@ -18,7 +19,7 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 	securityMiddleware := common.NewSecurityMiddleware(
 		cfg.AllowedOrigins,
 		"GET,OPTIONS",
-		"Content-Type",
+		"Content-Type,If-None-Match",
 		false,
 	)
 	router.Use(securityMiddleware.Apply())
@@ -29,20 +30,62 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 	// Metrics
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
+	cacheContent := middleware.PublicCache(cfg.HTTPCacheMaxAge)
+
+	// Sitemap at the root, since crawlers only accept URLs under its own path
+	router.GET("/sitemap.xml", middleware.ETag(), cacheContent, handler.GetSitemap)
+
 	// API routes
 	v1 := router.Group("/api/v1")
+
+	// JSON content routes: strong ETags with conditional GET and per-route Cache-Control
+	content := v1.Group("", middleware.ETag())
 	{
-		v1.GET("/profile", handler.GetProfile)
-		v1.GET("/experience", handler.GetWorkExperience)
-		v1.GET("/certifications", handler.GetCertifications)
-		v1.GET("/skills", handler.GetSkills)
-		v1.GET("/projects", handler.GetProjects)
-		v1.GET("/projects/:id", handler.GetProjectByID)
-		v1.GET("/miniatures/themes", handler.GetMiniatureThemes)
-		v1.GET("/miniatures/themes/:id", handler.GetMiniatureThemeByID)
-		v1.GET("/miniatures/projects/:id", handler.GetMiniatureByID)
+		content.GET("/portfolio", cacheContent, handler.GetPortfolio)
+		content.GET("/profile", cacheContent, handler.GetProfile)
+		content.GET("/profile.vcf", cacheContent, handler.GetProfileVCard)
+		content.GET("/profile/qr.png", cacheContent, handler.GetProfileQR)
+		content.GET("/experience", cacheContent, handler.GetWorkExperience)
+		content.GET("/certifications", cacheContent, handler.GetCertifications)
+		content.GET("/skills", cacheContent, handler.GetSkills)
+		content.GET("/skills/:id/projects", cacheContent, handler.GetSkillProjects)
+		content.GET("/projects", cacheContent, handler.GetProjects)
+		content.GET("/projects/:id", cacheContent, handler.GetProjectByID)
+		content.GET("/projects/by-slug/:slug", cacheContent, handler.GetProjectBySlug)
+		content.GET("/projects/:id/navigation", cacheContent, handler.GetProjectNavigation)
+		content.GET("/miniatures", cacheContent, handler.GetMiniatures)
+		content.GET("/miniatures/themes", cacheContent, handler.GetMiniatureThemes)
+		content.GET("/miniatures/themes/:id", cacheContent, handler.GetMiniatureThemeByID)
+		content.GET("/miniatures/themes/by-slug/:slug", cacheContent, handler.GetMiniatureThemeBySlug)
+		content.GET("/miniatures/projects/:id", cacheContent, handler.GetMiniatureByID)
+		content.GET("/miniatures/projects/by-slug/:slug", cacheContent, handler.GetMiniatureBySlug)
+		content.GET("/miniatures/projects/:id/navigation", cacheContent, handler.GetMiniatureNavigation)
+		content.GET("/miniatures/paints", cacheContent, handler.GetPaints)
+		content.GET("/miniatures/paints/similar", cacheContent, handler.GetSimilarPaints)
+		content.GET("/miniatures/paints/:id", cacheContent, handler.GetPaintMiniatures)
+		content.GET("/miniatures/techniques", cacheContent, handler.GetTechniques)
+		content.GET("/miniatures/techniques/:id", cacheContent, handler.GetTechniqueMiniatures)
+		content.GET("/search", cacheContent, handler.Search)
+		content.GET("/feed.atom", cacheContent, handler.GetFeedAtom)
+		content.GET("/feed.rss", cacheContent, handler.GetFeedRSS)
+		content.GET("/feed.json", cacheContent, handler.GetFeedJSON)
+		content.GET("/meta/projects/:id", cacheContent, handler.GetProjectMeta)
+		content.GET("/meta/miniatures/:id", cacheContent, handler.GetMiniatureMeta)
+		content.GET("/meta/themes/:id", cacheContent, handler.GetThemeMeta)
+		content.GET("/og/projects/:file", cacheContent, handler.GetProjectOGImage)
+		content.GET("/resume.json", cacheContent, handler.GetResumeJSON)
+		content.GET("/resume.pdf", cacheContent, handler.GetResumePDF)
 	}
 
+	// Images answer conditional requests from the rendition key, without rendering
+	v1.GET("/img/:fileId", cacheContent, handler.GetImage)
+
+	// Streamed downloads: outside the ETag group, which buffers whole bodies, and
+	// never stored, since a stream cut short still ends with a 200 status
+	noStore := middleware.CacheControl("no-store")
+	v1.GET("/miniatures/themes/:id/archive.zip", noStore, handler.GetMiniatureThemeArchive)
+	v1.GET("/miniatures/projects/:id/archive.zip", noStore, handler.GetMiniatureArchive)
+
 	// Swagger documentation (only if SWAGGER_HOST is configured)
 	if cfg.SwaggerHost != "" {
 		docs.SwaggerInfo.Host = cfg.SwaggerHost
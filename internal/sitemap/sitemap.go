@@ -0,0 +1,103 @@
+// Package sitemap encodes URL lists as sitemaps.org 0.9 documents, splitting
+// them behind a sitemap index when they exceed the protocol's size limit.
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// ContentType of sitemap and sitemap index documents
+const ContentType = "application/xml; charset=utf-8"
+
+// MaxURLs is the protocol limit on the number of URLs in one sitemap
+const MaxURLs = 50000
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URL is a single page entry. A zero LastMod is omitted.
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []urlElement `xml:"url"`
+}
+
+type urlElement struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []urlElement `xml:"sitemap"`
+}
+
+// Pages returns how many sitemaps of at most size URLs are needed for n URLs
+func Pages(n, size int) int {
+	if n <= size {
+		return 1
+	}
+	return (n + size - 1) / size
+}
+
+// Page returns the 1-based page of at most size URLs, or false when page is out of range
+func Page(urls []URL, page, size int) ([]URL, bool) {
+	if page < 1 || page > Pages(len(urls), size) {
+		return nil, false
+	}
+	start := (page - 1) * size
+	end := min(start+size, len(urls))
+	return urls[start:end], true
+}
+
+// Encode renders a sitemap of the given URLs
+func Encode(urls []URL) ([]byte, error) {
+	doc := urlSet{Xmlns: namespace, URLs: make([]urlElement, 0, len(urls))}
+	for _, u := range urls {
+		doc.URLs = append(doc.URLs, element(u))
+	}
+	return marshal(doc)
+}
+
+// EncodeIndex renders a sitemap index pointing at the given sitemaps
+func EncodeIndex(sitemaps []URL) ([]byte, error) {
+	doc := sitemapIndex{Xmlns: namespace, Sitemaps: make([]urlElement, 0, len(sitemaps))}
+	for _, u := range sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, element(u))
+	}
+	return marshal(doc)
+}
+
+// LastModified returns the newest LastMod among urls, or the zero time when none is set
+func LastModified(urls []URL) time.Time {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
+
+func element(u URL) urlElement {
+	e := urlElement{Loc: u.Loc}
+	if !u.LastMod.IsZero() {
+		e.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+	}
+	return e
+}
+
+func marshal(doc any) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode sitemap: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
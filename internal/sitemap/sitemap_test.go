@@ -0,0 +1,86 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncode(t *testing.T) {
+	updated := time.Date(2024, 3, 1, 10, 0, 0, 0, time.FixedZone("EET", 2*60*60))
+	body, err := Encode([]URL{
+		{Loc: "https://example.com/projects/1?a=1&b=2", LastMod: updated},
+		{Loc: "https://example.com/"},
+	})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if !strings.HasPrefix(string(body), xml.Header) {
+		t.Errorf("Encode() missing XML declaration")
+	}
+
+	var doc urlSet
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Encode() produced invalid XML: %v\n%s", err, body)
+	}
+	if doc.Xmlns != namespace || len(doc.URLs) != 2 {
+		t.Fatalf("Encode() = %+v, want 2 URLs in the sitemap namespace", doc)
+	}
+	if doc.URLs[0].Loc != "https://example.com/projects/1?a=1&b=2" || doc.URLs[0].LastMod != "2024-03-01T08:00:00Z" {
+		t.Errorf("url = %+v, want escaped loc round trip and UTC lastmod", doc.URLs[0])
+	}
+	if strings.Contains(string(body), "<lastmod></lastmod>") || doc.URLs[1].LastMod != "" {
+		t.Errorf("zero lastmod should be omitted:\n%s", body)
+	}
+}
+
+func TestEncodeIndex(t *testing.T) {
+	body, err := EncodeIndex([]URL{{Loc: "https://api.example.com/sitemap.xml?page=1"}})
+	if err != nil {
+		t.Fatalf("EncodeIndex() error = %v", err)
+	}
+
+	var doc sitemapIndex
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("EncodeIndex() produced invalid XML: %v\n%s", err, body)
+	}
+	if doc.XMLName.Local != "sitemapindex" || len(doc.Sitemaps) != 1 {
+		t.Errorf("EncodeIndex() = %+v, want one sitemap entry", doc)
+	}
+}
+
+func TestPage(t *testing.T) {
+	urls := make([]URL, 5)
+	for i := range urls {
+		urls[i].Loc = string(rune('a' + i))
+	}
+
+	if got := Pages(0, 2); got != 1 {
+		t.Errorf("Pages(0, 2) = %d, want 1", got)
+	}
+	if got := Pages(len(urls), 2); got != 3 {
+		t.Errorf("Pages(5, 2) = %d, want 3", got)
+	}
+
+	tests := []struct {
+		page int
+		want string
+		ok   bool
+	}{
+		{page: 1, want: "ab", ok: true},
+		{page: 3, want: "e", ok: true},
+		{page: 0},
+		{page: 4},
+	}
+	for _, tt := range tests {
+		chunk, ok := Page(urls, tt.page, 2)
+		var got strings.Builder
+		for _, u := range chunk {
+			got.WriteString(u.Loc)
+		}
+		if ok != tt.ok || got.String() != tt.want {
+			t.Errorf("Page(%d) = %q, %v, want %q, %v", tt.page, got.String(), ok, tt.want, tt.ok)
+		}
+	}
+}
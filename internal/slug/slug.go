@@ -0,0 +1,143 @@
+// Package slug derives stable, URL-safe slugs from titles and names.
+package slug
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxLength caps slugs so long titles still give readable URLs
+const maxLength = 80
+
+// Letters that Unicode decomposition does not reduce to ASCII
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i",
+}
+
+// Make lowercases text, strips diacritics and joins the remaining ASCII
+// letters and digits with hyphens. It returns "" when nothing is left.
+func Make(text string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range norm.NFKD.String(strings.ToLower(text)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		case transliterations[r] != "":
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteString(transliterations[r])
+			hyphen = false
+		default:
+			hyphen = true
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > maxLength {
+		slug = slug[:maxLength]
+		if i := strings.LastIndexByte(slug, '-'); i > 0 {
+			slug = slug[:i]
+		}
+	}
+	return slug
+}
+
+// Entry is a record to assign a slug to
+type Entry struct {
+	ID   int64
+	Name string
+}
+
+// Assign gives every entry a unique slug. Among entries whose names slugify
+// alike, the lowest ID keeps the plain slug and the others get their ID
+// appended, so existing slugs stay put when a duplicate is added later.
+// Names without any usable characters fall back to "<fallback>-<id>".
+func Assign(entries []Entry, fallback string) map[int64]string {
+	sorted := make([]Entry, len(entries))
+	copy(sorted, entries)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	slugs := make(map[int64]string, len(sorted))
+	used := make(map[string]bool, len(sorted))
+	type duplicate struct {
+		id   int64
+		base string
+	}
+	var duplicates []duplicate
+
+	// Plain slugs are claimed first so a suffixed slug never takes one
+	for _, entry := range sorted {
+		base := Make(entry.Name)
+		if base == "" {
+			base = fallback + "-" + strconv.FormatInt(entry.ID, 10)
+		}
+		if used[base] {
+			duplicates = append(duplicates, duplicate{entry.ID, base})
+			continue
+		}
+		slugs[entry.ID] = base
+		used[base] = true
+	}
+
+	for _, d := range duplicates {
+		base := fmt.Sprintf("%s-%d", d.base, d.id)
+		candidate := base
+		for n := 2; used[candidate]; n++ {
+			candidate = fmt.Sprintf("%s-%d", base, n)
+		}
+		slugs[d.id] = candidate
+		used[candidate] = true
+	}
+	return slugs
+}
+
+// Resolve returns the ID whose slug in slugs is value. Failing that, it
+// accepts the older forms a published link may still use, so they can be
+// redirected to the current slug: a bare ID, "<id>-<title>" and a
+// "<slug>-<id>" duplicate suffix that is no longer needed. A title rename
+// leaves no trace, so the slug of the old title is not found.
+func Resolve(slugs map[int64]string, value string) (int64, bool) {
+	for id, s := range slugs {
+		if s == value {
+			return id, true
+		}
+	}
+
+	prefix, _, _ := strings.Cut(value, "-")
+	if id, ok := parseID(prefix); ok {
+		if _, found := slugs[id]; found {
+			return id, true
+		}
+	}
+	if i := strings.LastIndexByte(value, '-'); i > 0 {
+		if id, ok := parseID(value[i+1:]); ok && slugs[id] == value[:i] {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// parseID parses a positive decimal ID without sign or leading zeros
+func parseID(s string) (int64, bool) {
+	if s == "" || s[0] < '1' || s[0] > '9' {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,90 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"simple", "Portfolio Website", "portfolio-website"},
+		{"punctuation", "  Go & Vue: a *REST* API!  ", "go-vue-a-rest-api"},
+		{"diacritics", "Gunārs Ķēniņš – Résumé", "gunars-kenins-resume"},
+		{"transliterated letters", "Straße Ærø Łódź", "strasse-aero-lodz"},
+		{"digits", "Warhammer 40,000", "warhammer-40-000"},
+		{"nothing usable", "★ — ★", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Make(tt.in); got != tt.want {
+				t.Errorf("Make(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+
+	long := Make(strings.Repeat("miniature ", 20))
+	if len(long) > maxLength || strings.HasSuffix(long, "-") || !strings.HasPrefix(long, "miniature-") {
+		t.Errorf("Make(long) = %q, want at most %d characters cut at a word", long, maxLength)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	slugs := Assign([]Entry{
+		{ID: 9, Name: "Space Marine"},
+		{ID: 3, Name: "Space Marine!"},
+		{ID: 5, Name: "space-marine-9"},
+		{ID: 7, Name: "★"},
+		{ID: 4, Name: "Knight"},
+	}, "miniature")
+
+	want := map[int64]string{
+		3: "space-marine",
+		4: "knight",
+		5: "space-marine-9",
+		7: "miniature-7",
+		// The lowest ID keeps the plain slug; the suffixed form is already taken
+		9: "space-marine-9-2",
+	}
+	for id, slug := range want {
+		if slugs[id] != slug {
+			t.Errorf("slug of %d = %q, want %q", id, slugs[id], slug)
+		}
+	}
+	if len(slugs) != len(want) {
+		t.Errorf("Assign() returned %d slugs, want %d", len(slugs), len(want))
+	}
+}
+
+func TestResolve(t *testing.T) {
+	slugs := map[int64]string{3: "space-marine", 9: "space-marine-9", 12: "knight", 40: "warhammer-40k"}
+
+	tests := []struct {
+		value string
+		id    int64
+		ok    bool
+	}{
+		{"space-marine", 3, true},
+		{"space-marine-9", 9, true},
+		// Older forms still name their record
+		{"12", 12, true},
+		{"12-old-title", 12, true},
+		{"knight-12", 12, true},
+		// Unknown IDs, foreign suffixes and malformed IDs do not
+		{"99-knight", 0, false},
+		{"paladin-12", 0, false},
+		{"012-knight", 0, false},
+		{"+12", 0, false},
+		{"old-title", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		id, ok := Resolve(slugs, tt.value)
+		if id != tt.id || ok != tt.ok {
+			t.Errorf("Resolve(%q) = %d, %v, want %d, %v", tt.value, id, ok, tt.id, tt.ok)
+		}
+	}
+}
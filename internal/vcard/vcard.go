@@ -0,0 +1,141 @@
+// Package vcard encodes the portfolio profile as a vCard 4.0 (RFC 6350).
+package vcard
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+)
+
+// ContentType is the media type registered for vCard by RFC 6350
+const ContentType = "text/vcard; charset=utf-8"
+
+// maxLineOctets is the RFC 6350 line length limit, excluding the CRLF
+const maxLineOctets = 75
+
+// FromProfile builds a vCard for profile. siteURL, when non-empty, is added as the primary URL.
+func FromProfile(profile *models.Profile, siteURL string) string {
+	return build(profile, siteURL, false)
+}
+
+// Compact builds a vCard without the photo and note, small enough to stay
+// easily scannable when encoded as a QR code
+func Compact(profile *models.Profile, siteURL string) string {
+	return build(profile, siteURL, true)
+}
+
+func build(profile *models.Profile, siteURL string, compact bool) string {
+	var card builder
+	card.line("BEGIN", "VCARD")
+	card.line("VERSION", "4.0")
+	card.line("FN", escape(profile.FullName))
+	card.line("N", structuredName(profile.FullName))
+
+	if profile.Title != "" {
+		card.line("TITLE", escape(profile.Title))
+	}
+	if profile.Email != "" {
+		card.line("EMAIL;TYPE=work", escape(profile.Email))
+	}
+	if phone := telURI(profile.Phone); phone != "" {
+		card.line("TEL;VALUE=uri;TYPE=\"voice,cell\"", phone)
+	}
+	if profile.Location != "" {
+		city, region, _ := strings.Cut(profile.Location, ",")
+		card.line("ADR;TYPE=work;LABEL=\""+quoteParam(profile.Location)+"\"",
+			";;;"+escape(strings.TrimSpace(city))+";"+escape(strings.TrimSpace(region))+";;")
+	}
+	if siteURL != "" {
+		card.line("URL;PREF=1", siteURL)
+	}
+	if profile.Github != "" {
+		card.line("URL;TYPE=github", profile.Github)
+	}
+	if profile.Linkedin != "" {
+		card.line("URL;TYPE=linkedin", profile.Linkedin)
+	}
+	if profile.AvatarFile != nil && profile.AvatarFile.URL != "" && !compact {
+		card.line("PHOTO", profile.AvatarFile.URL)
+	}
+	if profile.Bio != "" && !compact {
+		card.line("NOTE", escape(profile.Bio))
+	}
+	if !profile.UpdatedAt.IsZero() {
+		card.line("REV", profile.UpdatedAt.UTC().Format("20060102T150405Z"))
+	}
+	card.line("END", "VCARD")
+	return card.String()
+}
+
+// builder writes content lines with CRLF endings, folding them at 75 octets
+type builder struct {
+	strings.Builder
+}
+
+func (b *builder) line(name, value string) {
+	content := name + ":" + value
+	limit := maxLineOctets
+	for len(content) > limit {
+		// Fold on a rune boundary so multi-byte characters are never split
+		cut := limit
+		for !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		b.WriteString(content[:cut])
+		b.WriteString("\r\n ")
+		content = content[cut:]
+		// Continuation lines spend one octet on the leading space
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(content)
+	b.WriteString("\r\n")
+}
+
+// textEscaper applies RFC 6350 text value escaping
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	",", `\,`,
+	";", `\;`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escape escapes a text property value
+func escape(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// quoteParam makes a value safe inside a quoted parameter, which cannot contain DQUOTE or line breaks
+func quoteParam(value string) string {
+	return strings.NewReplacer(`"`, "'", "\r", " ", "\n", " ").Replace(value)
+}
+
+// structuredName splits a full name into the N property's family and given components.
+// The last word is taken as the family name.
+func structuredName(fullName string) string {
+	words := strings.Fields(fullName)
+	if len(words) == 0 {
+		return ";;;;"
+	}
+	family := words[len(words)-1]
+	given := strings.Join(words[:len(words)-1], " ")
+	return escape(family) + ";" + escape(given) + ";;;"
+}
+
+// telURI converts a free-form phone number to a tel: URI, keeping digits and a leading +
+func telURI(phone string) string {
+	var digits strings.Builder
+	for i, r := range strings.TrimSpace(phone) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			digits.WriteRune(r)
+		}
+	}
+	if digits.Len() == 0 {
+		return ""
+	}
+	return "tel:" + digits.String()
+}
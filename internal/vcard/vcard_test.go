@@ -0,0 +1,110 @@
+package vcard
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	commonModels "github.com/GunarsK-portfolio/portfolio-common/models"
+	"github.com/GunarsK-portfolio/public-api/internal/models"
+)
+
+func testProfile() *models.Profile {
+	return &models.Profile{
+		Profile: commonModels.Profile{
+			FullName:  "Jānis Bērziņš",
+			Title:     "Engineer, Go; Vue",
+			Email:     "janis@example.com",
+			Phone:     "+371 (20) 123-456",
+			Location:  "Riga, Latvia",
+			Github:    "https://github.com/janis",
+			Bio:       strings.Repeat("Builds reliable services. ", 6) + "\nLine two.",
+			UpdatedAt: time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		},
+		AvatarFile: &models.StorageFile{
+			StorageFile: commonModels.StorageFile{
+				URL: "http://files/avatars/janis.jpg",
+			},
+		},
+	}
+}
+
+// unfold reverses RFC 6350 line folding and splits the card into content lines
+func unfold(card string) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(card, "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+func TestFromProfile(t *testing.T) {
+	card := FromProfile(testProfile(), "https://example.com")
+	lines := unfold(card)
+
+	want := []string{
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"FN:Jānis Bērziņš",
+		"N:Bērziņš;Jānis;;;",
+		`TITLE:Engineer\, Go\; Vue`,
+		"EMAIL;TYPE=work:janis@example.com",
+		`TEL;VALUE=uri;TYPE="voice,cell":tel:+37120123456`,
+		`ADR;TYPE=work;LABEL="Riga, Latvia":;;;Riga;Latvia;;`,
+		"URL;PREF=1:https://example.com",
+		"URL;TYPE=github:https://github.com/janis",
+		"PHOTO:http://files/avatars/janis.jpg",
+		"REV:20240301T123000Z",
+		"END:VCARD",
+	}
+	for _, line := range want {
+		found := false
+		for _, got := range lines {
+			if got == line {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("vCard missing line %q\n%s", line, card)
+		}
+	}
+
+	if lines[0] != "BEGIN:VCARD" || lines[1] != "VERSION:4.0" || lines[len(lines)-1] != "END:VCARD" {
+		t.Errorf("vCard must start with BEGIN and VERSION and end with END, got %q ... %q", lines[:2], lines[len(lines)-1])
+	}
+}
+
+func TestFromProfile_FoldsLongLines(t *testing.T) {
+	card := FromProfile(testProfile(), "")
+
+	if !strings.HasSuffix(card, "\r\n") {
+		t.Error("vCard must end with CRLF")
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(card, "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line exceeds %d octets: %q", maxLineOctets, line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("folding split a multi-byte character: %q", line)
+		}
+	}
+
+	var note string
+	for _, line := range unfold(card) {
+		if strings.HasPrefix(line, "NOTE:") {
+			note = line
+		}
+	}
+	if !strings.HasSuffix(note, `\nLine two.`) {
+		t.Errorf("NOTE = %q, want escaped newline preserved after unfolding", note)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	card := Compact(testProfile(), "https://example.com")
+
+	if strings.Contains(card, "PHOTO") || strings.Contains(card, "NOTE") {
+		t.Errorf("Compact() should omit PHOTO and NOTE:\n%s", card)
+	}
+	if !strings.Contains(card, "EMAIL;TYPE=work:janis@example.com") {
+		t.Errorf("Compact() missing contact details:\n%s", card)
+	}
+}